@@ -0,0 +1,289 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// API key scopes
+const (
+	ScopeContentRead  = "content:read"
+	ScopeContentWrite = "content:write"
+	ScopeGenerate     = "generate"
+	ScopeAdmin        = "admin"
+)
+
+// Scopes lists every scope a key can be granted
+var Scopes = []string{ScopeContentRead, ScopeContentWrite, ScopeGenerate, ScopeAdmin}
+
+const (
+	// apiKeyPrefix marks a bearer token as an API key
+	apiKeyPrefix = "lz_"
+	// lastUsedResolution bounds how often key use is written to disk
+	lastUsedResolution = time.Minute
+	// DefaultAPIKeyPath is where API keys are kept
+	DefaultAPIKeyPath = "data/api_keys.json"
+)
+
+// ErrKeyNotFound is returned when an API key does not exist or belongs to
+// someone else
+var ErrKeyNotFound = errors.New("api key not found")
+
+// APIKey is a stored API key. Only a SHA-256 digest of the secret is kept;
+// the full token is shown once, when the key is created.
+type APIKey struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Owner      string     `json:"owner"`
+	Scopes     []string   `json:"scopes"`
+	SecretHash string     `json:"secret_hash"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// Active reports whether the key can still authenticate
+func (k *APIKey) Active() bool {
+	return k.RevokedAt == nil
+}
+
+// APIKeyStore keeps API keys in a JSON file, rewritten atomically on change
+type APIKeyStore struct {
+	mutex   sync.Mutex
+	keys    map[string]*APIKey
+	version int64 // bumped by every snapshot
+	path    string
+	now     func() time.Time
+
+	// saveMutex orders writes of the file, so a snapshot written outside
+	// mutex never replaces a newer one
+	saveMutex sync.Mutex
+	saved     int64 // version of the snapshot on disk
+}
+
+// NewAPIKeyStore loads the keys stored at path, starting empty if the file
+// does not exist yet
+func NewAPIKeyStore(path string) (*APIKeyStore, error) {
+	s := &APIKeyStore{keys: make(map[string]*APIKey), path: path, now: time.Now}
+
+	var keys []*APIKey
+	if _, err := loadJSON(path, &keys); err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		s.keys[key.ID] = key
+	}
+	return s, nil
+}
+
+// Create issues a new key for owner and returns it with the full token,
+// which is not recoverable afterwards
+func (s *APIKeyStore) Create(owner, name string, scopes []string) (APIKey, string, error) {
+	scopes, err := normalizeScopes(scopes)
+	if err != nil {
+		return APIKey{}, "", err
+	}
+
+	id, err := randomHex(6)
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	secret, err := randomHex(24)
+	if err != nil {
+		return APIKey{}, "", err
+	}
+
+	key := &APIKey{
+		ID:         id,
+		Name:       name,
+		Owner:      owner,
+		Scopes:     scopes,
+		SecretHash: hashSecret(secret),
+		CreatedAt:  s.now().UTC(),
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.keys[id] = key
+	if err := s.save(); err != nil {
+		delete(s.keys, id)
+		return APIKey{}, "", err
+	}
+	return *key, apiKeyPrefix + id + "_" + secret, nil
+}
+
+// List returns owner's keys, newest first; an empty owner lists every key
+func (s *APIKeyStore) List(owner string) []APIKey {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	keys := []APIKey{}
+	for _, key := range s.keys {
+		if owner == "" || key.Owner == owner {
+			keys = append(keys, *key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].CreatedAt.Equal(keys[j].CreatedAt) {
+			return keys[i].CreatedAt.After(keys[j].CreatedAt)
+		}
+		return keys[i].ID < keys[j].ID
+	})
+	return keys
+}
+
+// Revoke disables a key. An empty owner may revoke any key.
+func (s *APIKeyStore) Revoke(owner, id string) (APIKey, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key, ok := s.keys[id]
+	if !ok || (owner != "" && key.Owner != owner) {
+		return APIKey{}, ErrKeyNotFound
+	}
+	if key.RevokedAt == nil {
+		now := s.now().UTC()
+		key.RevokedAt = &now
+		if err := s.save(); err != nil {
+			key.RevokedAt = nil
+			return APIKey{}, err
+		}
+	}
+	return *key, nil
+}
+
+// Authenticate accepts "Bearer lz_<id>_<secret>" tokens for active keys and
+// records when each key was last used
+func (s *APIKeyStore) Authenticate(r *http.Request) (*Identity, error) {
+	token := BearerToken(r)
+	if !strings.HasPrefix(token, apiKeyPrefix) {
+		return nil, nil
+	}
+
+	id, secret, ok := strings.Cut(strings.TrimPrefix(token, apiKeyPrefix), "_")
+	if !ok {
+		return nil, ErrInvalidCredentials
+	}
+
+	s.mutex.Lock()
+	key, ok := s.keys[id]
+	if !ok || !key.Active() || subtle.ConstantTimeCompare([]byte(key.SecretHash), []byte(hashSecret(secret))) != 1 {
+		s.mutex.Unlock()
+		return nil, ErrInvalidCredentials
+	}
+	identity := &Identity{
+		Subject: key.Owner,
+		Method:  "api_key",
+		KeyID:   key.ID,
+		Scopes:  append([]string(nil), key.Scopes...),
+	}
+
+	// Last use is recorded in memory under the lock and written to disk
+	// after it is released, so requests do not queue behind the fsync
+	var keys []APIKey
+	var version int64
+	now := s.now().UTC()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedResolution {
+		key.LastUsedAt = &now
+		keys, version = s.snapshot()
+	}
+	s.mutex.Unlock()
+
+	if keys != nil {
+		if err := s.persist(keys, version); err != nil {
+			// Losing a last-used timestamp is not worth failing the request
+			fmt.Printf("⚠️ Warning: Failed to record api key use: %v\n", err)
+		}
+	}
+	return identity, nil
+}
+
+// save writes every key to disk; callers must hold the lock
+func (s *APIKeyStore) save() error {
+	return s.persist(s.snapshot())
+}
+
+// snapshot copies every key, by ID, for writing; callers must hold the lock
+func (s *APIKeyStore) snapshot() ([]APIKey, int64) {
+	keys := make([]APIKey, 0, len(s.keys))
+	for _, key := range s.keys {
+		keys = append(keys, *key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+
+	s.version++
+	return keys, s.version
+}
+
+// persist writes keys, the snapshot taken at version, unless a later
+// snapshot is already on disk
+func (s *APIKeyStore) persist(keys []APIKey, version int64) error {
+	s.saveMutex.Lock()
+	defer s.saveMutex.Unlock()
+
+	if version <= s.saved {
+		return nil
+	}
+	if err := saveJSON(s.path, keys); err != nil {
+		return err
+	}
+	s.saved = version
+	return nil
+}
+
+// normalizeScopes validates, deduplicates and sorts requested scopes
+func normalizeScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+
+	seen := make(map[string]bool)
+	var out []string
+	for _, scope := range scopes {
+		if !validScope(scope) {
+			return nil, fmt.Errorf("unknown scope %q", scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			out = append(out, scope)
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// validScope reports whether scope is one of Scopes
+func validScope(scope string) bool {
+	for _, s := range Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// hashSecret is the stored form of a key secret. Secrets are 192 random
+// bits, so a fast hash is enough.
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomHex returns n random bytes as hex
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func authenticate(a Authenticator, token string) (*Identity, error) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	return a.Authenticate(req)
+}
+
+func TestAPIKeyLifecycle(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	store, err := NewAPIKeyStore(path)
+	if err != nil {
+		t.Fatalf("NewAPIKeyStore: %v", err)
+	}
+
+	key, token, err := store.Create("alice", "pipeline", []string{ScopeContentWrite, ScopeContentRead, ScopeContentRead})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if !strings.HasPrefix(token, "lz_"+key.ID+"_") {
+		t.Fatalf("token %q does not name key %s", token, key.ID)
+	}
+	if strings.Contains(key.SecretHash, strings.TrimPrefix(token, "lz_"+key.ID+"_")) {
+		t.Fatal("secret stored in clear")
+	}
+
+	id, err := authenticate(store, token)
+	if err != nil || id.Subject != "alice" || id.KeyID != key.ID {
+		t.Fatalf("Authenticate = %+v, %v", id, err)
+	}
+	if !id.HasScope(ScopeContentRead) || !id.HasScope(ScopeContentWrite) || id.HasScope(ScopeAdmin) {
+		t.Fatalf("scopes = %v", id.Scopes)
+	}
+
+	// Keys, revocations and last use survive a reload
+	reloaded, err := NewAPIKeyStore(path)
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	if keys := reloaded.List("alice"); len(keys) != 1 || keys[0].LastUsedAt == nil {
+		t.Fatalf("List after reload = %+v", keys)
+	}
+
+	if _, err := reloaded.Revoke("bob", key.ID); err != ErrKeyNotFound {
+		t.Fatalf("Revoke by another owner = %v, want ErrKeyNotFound", err)
+	}
+	if _, err := reloaded.Revoke("alice", key.ID); err != nil {
+		t.Fatalf("Revoke: %v", err)
+	}
+	if _, err := authenticate(reloaded, token); err != ErrInvalidCredentials {
+		t.Fatalf("revoked key authenticated: %v", err)
+	}
+}
+
+func TestAPIKeyRejectsBadTokens(t *testing.T) {
+	store, _ := NewAPIKeyStore(filepath.Join(t.TempDir(), "keys.json"))
+	key, token, _ := store.Create("alice", "ci", []string{ScopeGenerate})
+
+	for _, bad := range []string{"lz_" + key.ID + "_wrong", "lz_nope_secret", "lz_" + key.ID, token + "x"} {
+		if _, err := authenticate(store, bad); err != ErrInvalidCredentials {
+			t.Errorf("token %q: err = %v, want ErrInvalidCredentials", bad, err)
+		}
+	}
+
+	// Other bearer tokens are left to other authenticators
+	if id, err := authenticate(store, "eyJ.a.b"); id != nil || err != nil {
+		t.Errorf("non-key token = %+v, %v", id, err)
+	}
+
+	if _, _, err := store.Create("alice", "x", []string{"everything"}); err == nil {
+		t.Error("created a key with an unknown scope")
+	}
+	if _, _, err := store.Create("alice", "x", nil); err == nil {
+		t.Error("created a key without scopes")
+	}
+}
+
+func TestAPIKeyLastUseIsWrittenOutsideTheLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	store, err := NewAPIKeyStore(path)
+	if err != nil {
+		t.Fatalf("NewAPIKeyStore: %v", err)
+	}
+	_, token, err := store.Create("alice", "pipeline", []string{ScopeContentRead})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	// With the file write held up, the key store stays usable and the use
+	// is already visible in memory
+	store.saveMutex.Lock()
+	done := make(chan error)
+	go func() {
+		_, err := authenticate(store, token)
+		done <- err
+	}()
+	deadline := time.Now().Add(5 * time.Second)
+	for keys := store.List("alice"); keys[0].LastUsedAt == nil; keys = store.List("alice") {
+		if time.Now().After(deadline) {
+			t.Fatal("last use not recorded in memory")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	store.saveMutex.Unlock()
+	if err := <-done; err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+
+	reloaded, _ := NewAPIKeyStore(path)
+	if keys := reloaded.List("alice"); len(keys) != 1 || keys[0].LastUsedAt == nil {
+		t.Fatalf("List after reload = %+v", keys)
+	}
+}
+
+func TestAPIKeyStaleSnapshotIsNotWritten(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	store, err := NewAPIKeyStore(path)
+	if err != nil {
+		t.Fatalf("NewAPIKeyStore: %v", err)
+	}
+
+	store.mutex.Lock()
+	stale, version := store.snapshot()
+	store.mutex.Unlock()
+	if _, _, err := store.Create("alice", "pipeline", []string{ScopeContentRead}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if err := store.persist(stale, version); err != nil {
+		t.Fatalf("persist: %v", err)
+	}
+
+	reloaded, _ := NewAPIKeyStore(path)
+	if keys := reloaded.List(""); len(keys) != 1 {
+		t.Fatalf("an older snapshot replaced the file: %+v", keys)
+	}
+}
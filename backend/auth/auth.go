@@ -0,0 +1,151 @@
+// Package auth establishes who is calling the API. Authenticators turn
+// request credentials into an Identity, Middleware stores that identity in
+// the request context and RequireAuth rejects anonymous callers.
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"licenz-backend/models"
+)
+
+// ErrInvalidCredentials is returned by an Authenticator that recognised
+// the credentials as its own but could not accept them
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// Identity is an authenticated caller
+type Identity struct {
+	// Subject is the caller's user ID, stored as Content.UserID on
+	// everything they create
+	Subject string
+	// Method names the authenticator that established the identity
+	Method string
+	// KeyID is the API key used, if any
+	KeyID string
+	// Scopes limits what an API key may do; nil means unrestricted
+	Scopes []string
+	// Role is filled in by UserStore.AttachRoles
+	Role Role
+}
+
+// HasScope reports whether the identity may act within scope
+func (id *Identity) HasScope(scope string) bool {
+	if id.Scopes == nil {
+		return true
+	}
+	for _, s := range id.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Authenticator extracts an identity from a request. It returns nil, nil
+// when the request carries no credentials it recognises, so the next
+// authenticator can try.
+type Authenticator interface {
+	Authenticate(r *http.Request) (*Identity, error)
+}
+
+type contextKey struct{}
+
+// WithIdentity returns a copy of ctx carrying id
+func WithIdentity(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the identity stored by Middleware, if any
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(contextKey{}).(*Identity)
+	return id, ok && id != nil
+}
+
+// RoleOf returns the role of the caller in ctx: viewer when anonymous,
+// DefaultRole when no role was attached
+func RoleOf(ctx context.Context) Role {
+	id, ok := FromContext(ctx)
+	switch {
+	case !ok:
+		return RoleViewer
+	case id.Role == "":
+		return DefaultRole
+	default:
+		return id.Role
+	}
+}
+
+// Middleware tries each authenticator in turn and stores the first identity
+// found in the request context. Requests without credentials continue
+// anonymously; requests with credentials nobody accepts get a 401.
+func Middleware(authenticators ...Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, a := range authenticators {
+			id, err := a.Authenticate(c.Request)
+			if err != nil {
+				abortUnauthorized(c, err.Error())
+				return
+			}
+			if id != nil {
+				c.Request = c.Request.WithContext(WithIdentity(c.Request.Context(), id))
+				c.Next()
+				return
+			}
+		}
+
+		if c.GetHeader("Authorization") != "" {
+			abortUnauthorized(c, ErrInvalidCredentials.Error())
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireAuth rejects requests that Middleware left anonymous
+func RequireAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := FromContext(c.Request.Context()); !ok {
+			abortUnauthorized(c, "Authentication required")
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireScope rejects authenticated callers whose credentials do not carry
+// scope. Anonymous requests pass through; combine with RequireAuth where a
+// caller is required.
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if id, ok := FromContext(c.Request.Context()); ok && !id.HasScope(scope) {
+			c.AbortWithStatusJSON(http.StatusForbidden, models.ContentResponse{
+				Success: false,
+				Error:   "Credentials lack the " + scope + " scope",
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
+// BearerToken returns the token from an "Authorization: Bearer" header
+func BearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if len(header) > 7 && strings.EqualFold(header[:7], "Bearer ") {
+		return strings.TrimSpace(header[7:])
+	}
+	return ""
+}
+
+// abortUnauthorized ends the request with a 401 in the API's error format
+func abortUnauthorized(c *gin.Context, message string) {
+	c.Header("WWW-Authenticate", `Bearer realm="licenz"`)
+	c.AbortWithStatusJSON(http.StatusUnauthorized, models.ContentResponse{
+		Success: false,
+		Error:   message,
+	})
+}
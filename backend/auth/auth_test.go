@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func testRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	tokens, err := ParseStaticTokens("secret-a=alice, secret-b=bob")
+	if err != nil {
+		t.Fatalf("ParseStaticTokens: %v", err)
+	}
+
+	r := gin.New()
+	r.Use(Middleware(tokens))
+	whoami := func(c *gin.Context) {
+		if id, ok := FromContext(c.Request.Context()); ok {
+			c.String(http.StatusOK, id.Subject)
+			return
+		}
+		c.String(http.StatusOK, "anonymous")
+	}
+	r.GET("/open", whoami)
+	r.GET("/closed", RequireAuth(), whoami)
+	return r
+}
+
+func TestMiddleware(t *testing.T) {
+	r := testRouter(t)
+
+	cases := []struct {
+		path, header string
+		status       int
+		body         string
+	}{
+		{"/open", "", http.StatusOK, "anonymous"},
+		{"/open", "Bearer secret-a", http.StatusOK, "alice"},
+		{"/open", "bearer secret-b", http.StatusOK, "bob"},
+		{"/open", "Bearer wrong", http.StatusUnauthorized, ""},
+		{"/closed", "", http.StatusUnauthorized, ""},
+		{"/closed", "Bearer secret-b", http.StatusOK, "bob"},
+	}
+
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		if tc.header != "" {
+			req.Header.Set("Authorization", tc.header)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != tc.status {
+			t.Errorf("%s with %q: status %d, want %d", tc.path, tc.header, w.Code, tc.status)
+			continue
+		}
+		if tc.body != "" && w.Body.String() != tc.body {
+			t.Errorf("%s with %q: body %q, want %q", tc.path, tc.header, w.Body.String(), tc.body)
+		}
+	}
+}
+
+func TestParseStaticTokensRejectsMalformedEntries(t *testing.T) {
+	for _, spec := range []string{"token", "=alice", "token="} {
+		if _, err := ParseStaticTokens(spec); err == nil {
+			t.Errorf("ParseStaticTokens(%q) succeeded", spec)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+)
+
+const (
+	// nonceLength is comfortably above EIP-4361's eight-character minimum
+	nonceLength = 17
+	// nonceTTL is how long a client has to sign and submit a message
+	nonceTTL = 10 * time.Minute
+	// nonceAlphabet keeps nonces alphanumeric, as EIP-4361 requires
+	nonceAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	// maxNonces bounds the nonces outstanding at once, so unauthenticated
+	// callers cannot grow the store without limit
+	maxNonces = 10000
+)
+
+// ErrTooManyNonces is returned by Issue while maxNonces are outstanding
+var ErrTooManyNonces = errors.New("too many sign-ins in progress")
+
+// NonceStore hands out single-use sign-in nonces and remembers them until
+// they are consumed or expire
+type NonceStore struct {
+	mutex  sync.Mutex
+	issued map[string]time.Time // nonce -> expiry
+	now    func() time.Time
+}
+
+// NewNonceStore creates an empty nonce store
+func NewNonceStore() *NonceStore {
+	return &NonceStore{issued: make(map[string]time.Time), now: time.Now}
+}
+
+// Issue returns a fresh nonce valid for nonceTTL
+func (s *NonceStore) Issue() (string, error) {
+	nonce := make([]byte, nonceLength)
+	max := big.NewInt(int64(len(nonceAlphabet)))
+	for i := range nonce {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		nonce[i] = nonceAlphabet[n.Int64()]
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Expired nonces are only swept once the store fills up
+	if len(s.issued) >= maxNonces {
+		s.sweep()
+		if len(s.issued) >= maxNonces {
+			return "", ErrTooManyNonces
+		}
+	}
+	s.issued[string(nonce)] = s.now().Add(nonceTTL)
+	return string(nonce), nil
+}
+
+// Consume reports whether nonce was issued and is still valid, and makes
+// sure it can never be used again
+func (s *NonceStore) Consume(nonce string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	expiry, ok := s.issued[nonce]
+	delete(s.issued, nonce)
+	return ok && s.now().Before(expiry)
+}
+
+// sweep drops expired nonces; callers must hold the lock
+func (s *NonceStore) sweep() {
+	now := s.now()
+	for nonce, expiry := range s.issued {
+		if !now.Before(expiry) {
+			delete(s.issued, nonce)
+		}
+	}
+}
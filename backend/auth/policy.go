@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+
+	"licenz-backend/models"
+)
+
+// Action is something the policy decides on
+type Action string
+
+const (
+	ActionCreateContent Action = "content:create"
+	ActionUpdateContent Action = "content:update"
+	ActionDeleteContent Action = "content:delete"
+	ActionHideContent   Action = "content:hide"
+	ActionViewHidden    Action = "content:view_hidden"
+	ActionBackup        Action = "admin:backup"
+	ActionManageUsers   Action = "admin:users"
+)
+
+// Allowed reports whether the caller in ctx may perform action. content is
+// the record acted on, or nil for actions that are not about one record.
+//
+//	viewer     read public content
+//	creator    create content; update and delete their own
+//	moderator  hide and unhide anyone's content, see hidden content
+//	admin      everything, including backups and role management
+func Allowed(ctx context.Context, action Action, content *models.Content) bool {
+	role := RoleOf(ctx)
+	if role.AtLeast(RoleAdmin) {
+		return true
+	}
+
+	id, _ := FromContext(ctx)
+	owns := id != nil && content != nil && content.UserID != "" && content.UserID == id.Subject
+
+	switch action {
+	case ActionCreateContent:
+		return role.AtLeast(RoleCreator)
+	case ActionUpdateContent, ActionDeleteContent:
+		return role.AtLeast(RoleCreator) && owns
+	case ActionHideContent:
+		return role.AtLeast(RoleModerator)
+	case ActionViewHidden:
+		return role.AtLeast(RoleModerator) || owns
+	default:
+		return false
+	}
+}
@@ -0,0 +1,201 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"licenz-backend/models"
+)
+
+// Role is what an identity is allowed to do, from least to most privileged
+type Role string
+
+const (
+	RoleViewer    Role = "viewer"
+	RoleCreator   Role = "creator"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+// DefaultRole is held by every signed-in identity without an explicit role
+const DefaultRole = RoleCreator
+
+// DefaultUserPath is where role assignments are kept
+const DefaultUserPath = "data/users.json"
+
+// ErrBootstrapAdmin is returned when changing the role of an admin listed in
+// ADMIN_SUBJECTS
+var ErrBootstrapAdmin = errors.New("subject is an admin by configuration")
+
+// roleRank orders roles so each includes the ones below it
+var roleRank = map[Role]int{RoleViewer: 1, RoleCreator: 2, RoleModerator: 3, RoleAdmin: 4}
+
+// ParseRole validates a role name
+func ParseRole(name string) (Role, error) {
+	role := Role(strings.ToLower(strings.TrimSpace(name)))
+	if _, ok := roleRank[role]; !ok {
+		return "", fmt.Errorf("unknown role %q", name)
+	}
+	return role, nil
+}
+
+// AtLeast reports whether r includes every permission of min
+func (r Role) AtLeast(min Role) bool {
+	return roleRank[r] >= roleRank[min]
+}
+
+// User is a role assignment
+type User struct {
+	Subject   string    `json:"subject"`
+	Role      Role      `json:"role"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy string    `json:"updated_by,omitempty"`
+	Bootstrap bool      `json:"bootstrap,omitempty"` // admin from configuration, not editable
+}
+
+// UserStore assigns roles to subjects. Assignments live in a JSON file;
+// bootstrap admins come from configuration and always win.
+type UserStore struct {
+	mutex  sync.RWMutex
+	users  map[string]*User
+	admins map[string]bool
+	path   string
+	now    func() time.Time
+}
+
+// NewUserStore loads role assignments from path. admins are subjects that
+// are always admin, as listed in ADMIN_SUBJECTS; addresses may be written in
+// any case.
+func NewUserStore(path string, admins []string) (*UserStore, error) {
+	s := &UserStore{
+		users:  make(map[string]*User),
+		admins: make(map[string]bool),
+		path:   path,
+		now:    time.Now,
+	}
+	for _, subject := range admins {
+		if subject = normalizeSubject(subject); subject != "" {
+			s.admins[subject] = true
+		}
+	}
+
+	var users []*User
+	if _, err := loadJSON(path, &users); err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		u.Subject = normalizeSubject(u.Subject)
+		s.users[u.Subject] = u
+	}
+	return s, nil
+}
+
+// Role returns the role held by subject
+func (s *UserStore) Role(subject string) Role {
+	subject = normalizeSubject(subject)
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if s.admins[subject] {
+		return RoleAdmin
+	}
+	if u, ok := s.users[subject]; ok {
+		return u.Role
+	}
+	return DefaultRole
+}
+
+// List returns every explicit assignment and bootstrap admin, by subject
+func (s *UserStore) List() []User {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	users := []User{}
+	for subject := range s.admins {
+		users = append(users, User{Subject: subject, Role: RoleAdmin, Bootstrap: true})
+	}
+	for _, u := range s.users {
+		if !s.admins[u.Subject] {
+			users = append(users, *u)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Subject < users[j].Subject })
+	return users
+}
+
+// SetRole assigns role to subject on behalf of by
+func (s *UserStore) SetRole(subject string, role Role, by string) (User, error) {
+	subject = normalizeSubject(subject)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.admins[subject] {
+		return User{}, ErrBootstrapAdmin
+	}
+
+	previous, existed := s.users[subject]
+	u := &User{Subject: subject, Role: role, UpdatedAt: s.now().UTC(), UpdatedBy: by}
+	s.users[subject] = u
+
+	if err := s.save(); err != nil {
+		if existed {
+			s.users[subject] = previous
+		} else {
+			delete(s.users, subject)
+		}
+		return User{}, err
+	}
+	return *u, nil
+}
+
+// AttachRoles sets Identity.Role on requests Middleware authenticated
+func (s *UserStore) AttachRoles() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if id, ok := FromContext(c.Request.Context()); ok {
+			id.Role = s.Role(id.Subject)
+		}
+		c.Next()
+	}
+}
+
+// normalizeSubject trims subject and checksums it when it is an Ethereum
+// address, the form SIWE sessions carry
+func normalizeSubject(subject string) string {
+	subject = strings.TrimSpace(subject)
+	if strings.HasPrefix(strings.ToLower(subject), "0x") && common.IsHexAddress(subject) {
+		return common.HexToAddress(subject).Hex()
+	}
+	return subject
+}
+
+// save writes every assignment to disk; callers must hold the write lock
+func (s *UserStore) save() error {
+	users := make([]*User, 0, len(s.users))
+	for _, u := range s.users {
+		users = append(users, u)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Subject < users[j].Subject })
+
+	return saveJSON(s.path, users)
+}
+
+// RequireRole rejects callers below min. Anonymous callers count as viewers.
+func RequireRole(min Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !RoleOf(c.Request.Context()).AtLeast(min) {
+			c.AbortWithStatusJSON(http.StatusForbidden, models.ContentResponse{
+				Success: false,
+				Error:   "Requires the " + string(min) + " role",
+			})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"licenz-backend/models"
+)
+
+func TestAllowed(t *testing.T) {
+	as := func(subject string, role Role) context.Context {
+		return WithIdentity(context.Background(), &Identity{Subject: subject, Role: role})
+	}
+	owned := &models.Content{UserID: "alice"}
+
+	cases := []struct {
+		name    string
+		ctx     context.Context
+		action  Action
+		content *models.Content
+		want    bool
+	}{
+		{"anonymous create", context.Background(), ActionCreateContent, nil, false},
+		{"viewer create", as("alice", RoleViewer), ActionCreateContent, nil, false},
+		{"creator create", as("alice", RoleCreator), ActionCreateContent, nil, true},
+		{"owner update", as("alice", RoleCreator), ActionUpdateContent, owned, true},
+		{"other update", as("bob", RoleCreator), ActionUpdateContent, owned, false},
+		{"viewer owner delete", as("alice", RoleViewer), ActionDeleteContent, owned, false},
+		{"owner hide", as("alice", RoleCreator), ActionHideContent, owned, false},
+		{"moderator hide", as("bob", RoleModerator), ActionHideContent, owned, true},
+		{"moderator update", as("bob", RoleModerator), ActionUpdateContent, owned, false},
+		{"owner view hidden", as("alice", RoleCreator), ActionViewHidden, owned, true},
+		{"anonymous view hidden", context.Background(), ActionViewHidden, owned, false},
+		{"moderator backup", as("bob", RoleModerator), ActionBackup, nil, false},
+		{"admin delete", as("root", RoleAdmin), ActionDeleteContent, owned, true},
+		{"admin users", as("root", RoleAdmin), ActionManageUsers, nil, true},
+	}
+	for _, tc := range cases {
+		if got := Allowed(tc.ctx, tc.action, tc.content); got != tc.want {
+			t.Errorf("%s: Allowed = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestUserStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "users.json")
+	store, err := NewUserStore(path, []string{" root ", ""})
+	if err != nil {
+		t.Fatalf("NewUserStore: %v", err)
+	}
+
+	if role := store.Role("alice"); role != DefaultRole {
+		t.Fatalf("default role = %s", role)
+	}
+	if role := store.Role("root"); role != RoleAdmin {
+		t.Fatalf("bootstrap admin role = %s", role)
+	}
+	if _, err := store.SetRole("root", RoleViewer, "root"); !errors.Is(err, ErrBootstrapAdmin) {
+		t.Fatalf("demoting a bootstrap admin = %v, want ErrBootstrapAdmin", err)
+	}
+
+	user, err := store.SetRole("alice", RoleModerator, "root")
+	if err != nil || user.UpdatedBy != "root" {
+		t.Fatalf("SetRole = %+v, %v", user, err)
+	}
+
+	reloaded, err := NewUserStore(path, nil)
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	if role := reloaded.Role("alice"); role != RoleModerator {
+		t.Fatalf("reloaded role = %s", role)
+	}
+	if users := store.List(); len(users) != 2 || !users[1].Bootstrap {
+		t.Fatalf("List = %+v", users)
+	}
+}
+
+func TestUserStoreNormalizesAddresses(t *testing.T) {
+	const checksummed = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	path := filepath.Join(t.TempDir(), "users.json")
+	store, err := NewUserStore(path, []string{strings.ToLower(checksummed)})
+	if err != nil {
+		t.Fatalf("NewUserStore: %v", err)
+	}
+
+	// SIWE sessions carry the checksummed address
+	if role := store.Role(checksummed); role != RoleAdmin {
+		t.Fatalf("role of a lowercase ADMIN_SUBJECTS entry = %s", role)
+	}
+	if users := store.List(); len(users) != 1 || users[0].Subject != checksummed {
+		t.Fatalf("List = %+v", users)
+	}
+
+	other := "0xFB6916095CA1DF60BB79CE92CE3EA74C37C5D359"
+	if _, err := store.SetRole(other, RoleViewer, checksummed); err != nil {
+		t.Fatalf("SetRole: %v", err)
+	}
+	if role := store.Role(strings.ToLower(other)); role != RoleViewer {
+		t.Fatalf("role looked up in lowercase = %s", role)
+	}
+	if role := store.Role("0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"); role != RoleViewer {
+		t.Fatalf("role looked up in mixed case = %s", role)
+	}
+}
+
+func TestParseRole(t *testing.T) {
+	if role, err := ParseRole(" Moderator "); err != nil || role != RoleModerator {
+		t.Fatalf("ParseRole = %s, %v", role, err)
+	}
+	if _, err := ParseRole("owner"); err == nil {
+		t.Fatal("accepted an unknown role")
+	}
+}
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// sessionIssuer is the iss claim on every session token
+	sessionIssuer = "licenz"
+	// minSessionSecret is the shortest HMAC key accepted for HS256
+	minSessionSecret = 32
+	// DefaultSessionTTL is how long a wallet login lasts
+	DefaultSessionTTL = 24 * time.Hour
+)
+
+// Sessions issues and verifies HS256 JWT session tokens. The subject is
+// the checksummed wallet address that signed in.
+type Sessions struct {
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewSessions creates a session issuer signing with secret
+func NewSessions(secret []byte, ttl time.Duration) (*Sessions, error) {
+	if len(secret) < minSessionSecret {
+		return nil, fmt.Errorf("session secret must be at least %d bytes", minSessionSecret)
+	}
+	if ttl <= 0 {
+		ttl = DefaultSessionTTL
+	}
+	return &Sessions{secret: secret, ttl: ttl, now: time.Now}, nil
+}
+
+// RandomSecret returns a fresh secret for deployments that did not
+// configure one; sessions signed with it do not survive a restart
+func RandomSecret() ([]byte, error) {
+	secret := make([]byte, minSessionSecret)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// Issue signs a session token for subject
+func (s *Sessions) Issue(subject string) (string, time.Time, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", time.Time{}, err
+	}
+
+	now := s.now()
+	expires := now.Add(s.ttl)
+	claims := jwt.RegisteredClaims{
+		Issuer:    sessionIssuer,
+		Subject:   subject,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expires),
+		ID:        hex.EncodeToString(id),
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign session: %v", err)
+	}
+	return token, expires, nil
+}
+
+// Verify checks a session token and returns the identity it carries
+func (s *Sessions) Verify(token string) (*Identity, error) {
+	claims := &jwt.RegisteredClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	parsed, err := parser.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return s.secret, nil
+	})
+	if err != nil || !parsed.Valid {
+		return nil, ErrInvalidCredentials
+	}
+
+	if !claims.VerifyIssuer(sessionIssuer, true) || claims.Subject == "" || !claims.VerifyExpiresAt(s.now(), true) {
+		return nil, ErrInvalidCredentials
+	}
+	return &Identity{Subject: claims.Subject, Method: "session"}, nil
+}
+
+// Authenticate accepts bearer tokens shaped like a JWT
+func (s *Sessions) Authenticate(r *http.Request) (*Identity, error) {
+	token := BearerToken(r)
+	if strings.Count(token, ".") != 2 {
+		return nil, nil
+	}
+	return s.Verify(token)
+}
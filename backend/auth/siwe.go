@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const siwePreamble = " wants you to sign in with your Ethereum account:"
+
+// SIWEMessage is a parsed EIP-4361 Sign-In with Ethereum message
+type SIWEMessage struct {
+	Domain         string
+	Address        common.Address
+	Statement      string
+	URI            string
+	Version        string
+	ChainID        int64
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime time.Time // zero when absent
+	NotBefore      time.Time // zero when absent
+	RequestID      string
+	Resources      []string
+}
+
+// ParseSIWEMessage parses the plain-text message a wallet signed
+func ParseSIWEMessage(text string) (*SIWEMessage, error) {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	msg := &SIWEMessage{}
+
+	domain, ok := strings.CutSuffix(lines[0], siwePreamble)
+	if !ok || domain == "" {
+		return nil, errors.New("message is not a Sign-In with Ethereum message")
+	}
+	msg.Domain = domain
+
+	if len(lines) < 2 {
+		return nil, errors.New("message is missing the address")
+	}
+	if err := parseChecksummedAddress(lines[1], &msg.Address); err != nil {
+		return nil, err
+	}
+
+	// An optional statement sits between blank lines before the fields
+	i := 2
+	for i < len(lines) && lines[i] == "" {
+		i++
+	}
+	if i < len(lines) && !strings.HasPrefix(lines[i], "URI: ") {
+		msg.Statement = lines[i]
+		i++
+		for i < len(lines) && lines[i] == "" {
+			i++
+		}
+	}
+
+	required := map[string]bool{"URI": false, "Version": false, "Chain ID": false, "Nonce": false, "Issued At": false}
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if line == "" {
+			continue
+		}
+		if line == "Resources:" {
+			for i++; i < len(lines) && strings.HasPrefix(lines[i], "- "); i++ {
+				msg.Resources = append(msg.Resources, strings.TrimPrefix(lines[i], "- "))
+			}
+			i--
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ": ")
+		if !ok {
+			return nil, fmt.Errorf("malformed line %q", line)
+		}
+		if _, known := required[key]; known {
+			required[key] = true
+		}
+
+		var err error
+		switch key {
+		case "URI":
+			msg.URI = value
+		case "Version":
+			msg.Version = value
+		case "Chain ID":
+			msg.ChainID, err = strconv.ParseInt(value, 10, 64)
+		case "Nonce":
+			msg.Nonce = value
+		case "Issued At":
+			msg.IssuedAt, err = time.Parse(time.RFC3339, value)
+		case "Expiration Time":
+			msg.ExpirationTime, err = time.Parse(time.RFC3339, value)
+		case "Not Before":
+			msg.NotBefore, err = time.Parse(time.RFC3339, value)
+		case "Request ID":
+			msg.RequestID = value
+		default:
+			return nil, fmt.Errorf("unknown field %q", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", key, err)
+		}
+	}
+
+	for key, seen := range required {
+		if !seen {
+			return nil, fmt.Errorf("message is missing %s", key)
+		}
+	}
+	if msg.Version != "1" {
+		return nil, fmt.Errorf("unsupported version %q", msg.Version)
+	}
+	if len(msg.Nonce) < 8 {
+		return nil, errors.New("nonce must be at least 8 characters")
+	}
+
+	return msg, nil
+}
+
+// parseChecksummedAddress accepts only EIP-55 mixed-case addresses, as
+// EIP-4361 requires
+func parseChecksummedAddress(s string, addr *common.Address) error {
+	if !common.IsHexAddress(s) {
+		return fmt.Errorf("invalid address %q", s)
+	}
+	*addr = common.HexToAddress(s)
+	if addr.Hex() != s {
+		return fmt.Errorf("address %q is not EIP-55 checksummed", s)
+	}
+	return nil
+}
+
+// ErrNoSIWEDomain is returned by Validate for a policy without a domain.
+// Accepting any domain would let a phishing site relay its visitors'
+// signatures, so sign-in is refused instead.
+var ErrNoSIWEDomain = errors.New("no sign-in domain is configured")
+
+// SIWEPolicy is what the server expects of every sign-in message
+type SIWEPolicy struct {
+	Domain  string // required domain
+	ChainID int64  // required chain ID; 0 accepts any
+}
+
+// Validate checks the message against the policy and its own validity window
+func (p SIWEPolicy) Validate(msg *SIWEMessage, now time.Time) error {
+	if p.Domain == "" {
+		return ErrNoSIWEDomain
+	}
+	if !strings.EqualFold(msg.Domain, p.Domain) {
+		return fmt.Errorf("message is for domain %q", msg.Domain)
+	}
+	if p.ChainID != 0 && msg.ChainID != p.ChainID {
+		return fmt.Errorf("message is for chain %d", msg.ChainID)
+	}
+	if !msg.ExpirationTime.IsZero() && !now.Before(msg.ExpirationTime) {
+		return errors.New("message has expired")
+	}
+	if !msg.NotBefore.IsZero() && now.Before(msg.NotBefore) {
+		return errors.New("message is not valid yet")
+	}
+	return nil
+}
+
+// VerifySIWESignature checks that signature is an EIP-191 personal_sign
+// signature of text by msg.Address
+func VerifySIWESignature(text string, msg *SIWEMessage, signature string) error {
+	sig, err := hexutil.Decode(signature)
+	if err != nil || len(sig) != crypto.SignatureLength {
+		return errors.New("signature must be 65 bytes of 0x-prefixed hex")
+	}
+
+	// Wallets produce v as 27/28; the recovery code is 0/1
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pub, err := crypto.SigToPub(accounts.TextHash([]byte(text)), sig)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %v", err)
+	}
+	if crypto.PubkeyToAddress(*pub) != msg.Address {
+		return errors.New("signature does not match address")
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const testMessage = `app.licenz.io wants you to sign in with your Ethereum account:
+%s
+
+Sign in to LicenZ
+
+URI: https://app.licenz.io/login
+Version: 1
+Chain ID: 11155111
+Nonce: %s
+Issued At: 2026-01-02T15:04:05Z
+Expiration Time: 2026-01-02T16:04:05Z
+Resources:
+- https://app.licenz.io/terms`
+
+// signedMessage returns a message for a fresh key and its wallet-style signature
+func signedMessage(t *testing.T, nonce string) (string, string, string) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	address := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	text := fmt.Sprintf(testMessage, address, nonce)
+
+	sig, err := crypto.Sign(accounts.TextHash([]byte(text)), key)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return text, hexutil.Encode(sig), address
+}
+
+func TestParseSIWEMessage(t *testing.T) {
+	text, _, address := signedMessage(t, "abcdefgh123")
+
+	msg, err := ParseSIWEMessage(text)
+	if err != nil {
+		t.Fatalf("ParseSIWEMessage: %v", err)
+	}
+	if msg.Domain != "app.licenz.io" || msg.Address.Hex() != address || msg.Statement != "Sign in to LicenZ" {
+		t.Fatalf("header fields = %+v", msg)
+	}
+	if msg.ChainID != 11155111 || msg.Nonce != "abcdefgh123" || msg.ExpirationTime.IsZero() {
+		t.Fatalf("fields = %+v", msg)
+	}
+	if len(msg.Resources) != 1 || msg.Resources[0] != "https://app.licenz.io/terms" {
+		t.Fatalf("resources = %v", msg.Resources)
+	}
+
+	// EIP-4361 requires a checksummed address
+	if _, err := ParseSIWEMessage(strings.Replace(text, address, strings.ToLower(address), 1)); err == nil {
+		t.Fatal("accepted a lowercase address")
+	}
+	if _, err := ParseSIWEMessage(strings.Replace(text, "Version: 1", "Version: 2", 1)); err == nil {
+		t.Fatal("accepted version 2")
+	}
+	if _, err := ParseSIWEMessage(strings.Replace(text, "Nonce: abcdefgh123\n", "", 1)); err == nil {
+		t.Fatal("accepted a message without a nonce")
+	}
+}
+
+func TestSIWEPolicyValidate(t *testing.T) {
+	text, _, _ := signedMessage(t, "abcdefgh123")
+	msg, err := ParseSIWEMessage(text)
+	if err != nil {
+		t.Fatalf("ParseSIWEMessage: %v", err)
+	}
+
+	during := time.Date(2026, 1, 2, 15, 30, 0, 0, time.UTC)
+	after := time.Date(2026, 1, 2, 17, 0, 0, 0, time.UTC)
+
+	if err := (SIWEPolicy{Domain: "app.licenz.io", ChainID: 11155111}).Validate(msg, during); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if err := (SIWEPolicy{Domain: "evil.example"}).Validate(msg, during); err == nil {
+		t.Fatal("accepted another domain")
+	}
+	if err := (SIWEPolicy{Domain: "app.licenz.io", ChainID: 1}).Validate(msg, during); err == nil {
+		t.Fatal("accepted another chain")
+	}
+	if err := (SIWEPolicy{Domain: "app.licenz.io"}).Validate(msg, after); err == nil {
+		t.Fatal("accepted an expired message")
+	}
+	if err := (SIWEPolicy{}).Validate(msg, during); !errors.Is(err, ErrNoSIWEDomain) {
+		t.Fatalf("policy without a domain = %v, want ErrNoSIWEDomain", err)
+	}
+}
+
+func TestVerifySIWESignature(t *testing.T) {
+	text, sig, _ := signedMessage(t, "abcdefgh123")
+	msg, err := ParseSIWEMessage(text)
+	if err != nil {
+		t.Fatalf("ParseSIWEMessage: %v", err)
+	}
+
+	if err := VerifySIWESignature(text, msg, sig); err != nil {
+		t.Fatalf("VerifySIWESignature: %v", err)
+	}
+
+	// A signature from another key, or over other text, must not verify
+	_, otherSig, _ := signedMessage(t, "abcdefgh123")
+	if err := VerifySIWESignature(text, msg, otherSig); err == nil {
+		t.Fatal("accepted another key's signature")
+	}
+	if err := VerifySIWESignature(text+" ", msg, sig); err == nil {
+		t.Fatal("accepted a signature over different text")
+	}
+}
+
+func TestSessions(t *testing.T) {
+	secret := []byte(strings.Repeat("s", minSessionSecret))
+	sessions, err := NewSessions(secret, time.Hour)
+	if err != nil {
+		t.Fatalf("NewSessions: %v", err)
+	}
+
+	token, _, err := sessions.Issue("0xAbC")
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	id, err := sessions.Verify(token)
+	if err != nil || id.Subject != "0xAbC" {
+		t.Fatalf("Verify = %+v, %v", id, err)
+	}
+
+	other, _ := NewSessions([]byte(strings.Repeat("x", minSessionSecret)), time.Hour)
+	if _, err := other.Verify(token); err == nil {
+		t.Fatal("accepted a token signed with another secret")
+	}
+
+	sessions.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if _, err := sessions.Verify(token); err == nil {
+		t.Fatal("accepted an expired token")
+	}
+
+	if _, err := NewSessions([]byte("short"), time.Hour); err == nil {
+		t.Fatal("accepted a short secret")
+	}
+}
+
+func TestNonceStore(t *testing.T) {
+	nonces := NewNonceStore()
+
+	nonce, err := nonces.Issue()
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	if len(nonce) < 8 {
+		t.Fatalf("nonce %q is too short", nonce)
+	}
+	if !nonces.Consume(nonce) {
+		t.Fatal("fresh nonce rejected")
+	}
+	if nonces.Consume(nonce) {
+		t.Fatal("nonce accepted twice")
+	}
+
+	expired, _ := nonces.Issue()
+	nonces.now = func() time.Time { return time.Now().Add(nonceTTL + time.Second) }
+	if nonces.Consume(expired) {
+		t.Fatal("expired nonce accepted")
+	}
+}
+
+func TestNonceStoreIsBounded(t *testing.T) {
+	nonces := NewNonceStore()
+	for i := 0; i < maxNonces; i++ {
+		if _, err := nonces.Issue(); err != nil {
+			t.Fatalf("Issue %d: %v", i, err)
+		}
+	}
+	if _, err := nonces.Issue(); !errors.Is(err, ErrTooManyNonces) {
+		t.Fatalf("Issue on a full store = %v, want ErrTooManyNonces", err)
+	}
+
+	// Expired nonces make room again
+	nonces.now = func() time.Time { return time.Now().Add(nonceTTL + time.Second) }
+	if _, err := nonces.Issue(); err != nil {
+		t.Fatalf("Issue after expiry: %v", err)
+	}
+	if len(nonces.issued) != 1 {
+		t.Fatalf("%d nonces outstanding, want only the new one", len(nonces.issued))
+	}
+}
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"licenz-backend/fsutil"
+)
+
+// loadJSON reads path into v, reporting false if the file does not exist yet
+func loadJSON(path string, v interface{}) (bool, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %v", path, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, fmt.Errorf("%s is corrupt: %v", path, err)
+	}
+	return true, nil
+}
+
+// saveJSON atomically replaces path with v, readable only by the owner
+func saveJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %v", filepath.Base(path), err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %v", filepath.Dir(path), err)
+	}
+	if err := fsutil.WriteFileAtomic(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to save %s: %v", filepath.Base(path), err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Package blobstore keeps image bytes out of content metadata. Blobs are
+// content-addressed: the key is the hex SHA-256 of the stored bytes, so
+// identical images uploaded by different users are stored exactly once.
+package blobstore
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"io"
+	"time"
+)
+
+// ErrNotFound is returned when no blob exists for a key
+var ErrNotFound = errors.New("blob not found")
+
+// Info describes a stored blob
+type Info struct {
+	Key     string    // hex SHA-256 of the blob bytes
+	Size    int64     // size in bytes
+	ModTime time.Time // when the blob was first stored
+}
+
+// Blob is an open, seekable handle on stored bytes
+type Blob interface {
+	io.ReadSeekCloser
+	Info() Info
+}
+
+// Store is the contract shared by every blob backend. It is deliberately
+// shaped after S3-compatible object storage (put by key, ranged reads, head,
+// delete) so a bucket-backed implementation can slot in behind it.
+type Store interface {
+	// Put streams r into the store and returns its content address.
+	// Storing bytes that already exist is a no-op that returns the same key.
+	Put(ctx context.Context, r io.Reader) (Info, error)
+	// Open returns a reader for the blob stored under key
+	Open(ctx context.Context, key string) (Blob, error)
+	// Stat returns metadata for the blob stored under key
+	Stat(ctx context.Context, key string) (Info, error)
+	// Delete removes the blob stored under key
+	Delete(ctx context.Context, key string) error
+}
+
+// ValidKey reports whether key looks like a hex SHA-256 digest
+func ValidKey(key string) bool {
+	if len(key) != 64 {
+		return false
+	}
+	_, err := hex.DecodeString(key)
+	return err == nil
+}
@@ -0,0 +1,141 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// FilesystemStore keeps blobs as files under a root directory, fanned out by
+// the first two bytes of the key (root/ab/cd/abcd...) to keep directories small
+type FilesystemStore struct {
+	root string
+}
+
+// NewFilesystemStore creates a blob store rooted at dir
+func NewFilesystemStore(dir string) (*FilesystemStore, error) {
+	if err := os.MkdirAll(filepath.Join(dir, "tmp"), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %v", err)
+	}
+	return &FilesystemStore{root: dir}, nil
+}
+
+// Root returns the directory blobs are stored under
+func (s *FilesystemStore) Root() string {
+	return s.root
+}
+
+// Put streams r to a temporary file while hashing it, then moves the file
+// into place under its SHA-256 key
+func (s *FilesystemStore) Put(ctx context.Context, r io.Reader) (Info, error) {
+	tmp, err := os.CreateTemp(filepath.Join(s.root, "tmp"), "upload-*")
+	if err != nil {
+		return Info{}, fmt.Errorf("failed to create temp blob: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tmp, hasher), r); err != nil {
+		return Info{}, fmt.Errorf("failed to write blob: %v", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return Info{}, err
+	}
+	if err := tmp.Sync(); err != nil {
+		return Info{}, fmt.Errorf("failed to sync blob: %v", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return Info{}, fmt.Errorf("failed to close blob: %v", err)
+	}
+
+	key := hex.EncodeToString(hasher.Sum(nil))
+	path := s.path(key)
+
+	// Identical bytes are already stored; keep the existing file
+	if existing, err := os.Stat(path); err == nil {
+		return Info{Key: key, Size: existing.Size(), ModTime: existing.ModTime()}, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return Info{}, fmt.Errorf("failed to create blob directory: %v", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return Info{}, fmt.Errorf("failed to store blob: %v", err)
+	}
+
+	return s.Stat(ctx, key)
+}
+
+// Open returns a reader for the blob stored under key
+func (s *FilesystemStore) Open(ctx context.Context, key string) (Blob, error) {
+	if !ValidKey(key) {
+		return nil, ErrNotFound
+	}
+
+	f, err := os.Open(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blob: %v", err)
+	}
+
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to stat blob: %v", err)
+	}
+
+	return &fileBlob{File: f, info: Info{Key: key, Size: stat.Size(), ModTime: stat.ModTime()}}, nil
+}
+
+// Stat returns metadata for the blob stored under key
+func (s *FilesystemStore) Stat(ctx context.Context, key string) (Info, error) {
+	if !ValidKey(key) {
+		return Info{}, ErrNotFound
+	}
+
+	stat, err := os.Stat(s.path(key))
+	if os.IsNotExist(err) {
+		return Info{}, ErrNotFound
+	}
+	if err != nil {
+		return Info{}, fmt.Errorf("failed to stat blob: %v", err)
+	}
+
+	return Info{Key: key, Size: stat.Size(), ModTime: stat.ModTime()}, nil
+}
+
+// Delete removes the blob stored under key
+func (s *FilesystemStore) Delete(ctx context.Context, key string) error {
+	if !ValidKey(key) {
+		return ErrNotFound
+	}
+
+	err := os.Remove(s.path(key))
+	if os.IsNotExist(err) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// path maps a key to its location on disk
+func (s *FilesystemStore) path(key string) string {
+	return filepath.Join(s.root, key[0:2], key[2:4], key)
+}
+
+// fileBlob adapts an *os.File to the Blob interface
+type fileBlob struct {
+	*os.File
+	info Info
+}
+
+// Info returns the blob metadata captured when it was opened
+func (b *fileBlob) Info() Info {
+	return b.info
+}
@@ -0,0 +1,163 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestStore(t *testing.T) *FilesystemStore {
+	t.Helper()
+	s, err := NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFilesystemStore: %v", err)
+	}
+	return s
+}
+
+// tempFiles lists what is left in the store's temp directory
+func tempFiles(t *testing.T, s *FilesystemStore) []string {
+	t.Helper()
+	entries, err := os.ReadDir(filepath.Join(s.Root(), "tmp"))
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	return names
+}
+
+func TestPutStoresByContentAddress(t *testing.T) {
+	s := newTestStore(t)
+	data := []byte("image bytes")
+	sum := sha256.Sum256(data)
+	key := hex.EncodeToString(sum[:])
+
+	info, err := s.Put(context.Background(), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if info.Key != key || info.Size != int64(len(data)) {
+		t.Fatalf("Put = %+v, want key %s and size %d", info, key, len(data))
+	}
+
+	// Fanned out by the first two bytes of the key
+	path := filepath.Join(s.Root(), key[0:2], key[2:4], key)
+	if got, err := os.ReadFile(path); err != nil || !bytes.Equal(got, data) {
+		t.Fatalf("blob at %s = %q, %v", path, got, err)
+	}
+
+	blob, err := s.Open(context.Background(), key)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer blob.Close()
+	if got, _ := io.ReadAll(blob); !bytes.Equal(got, data) || blob.Info().Key != key {
+		t.Fatalf("Open read %q with %+v", got, blob.Info())
+	}
+	if names := tempFiles(t, s); len(names) != 0 {
+		t.Errorf("temp files left after Put: %v", names)
+	}
+}
+
+func TestPutDeduplicates(t *testing.T) {
+	s := newTestStore(t)
+	first, err := s.Put(context.Background(), strings.NewReader("same bytes"))
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	path := s.path(first.Key)
+	before, _ := os.Stat(path)
+
+	second, err := s.Put(context.Background(), strings.NewReader("same bytes"))
+	if err != nil {
+		t.Fatalf("second Put: %v", err)
+	}
+	if second.Key != first.Key || second.Size != first.Size {
+		t.Fatalf("second Put = %+v, want %+v", second, first)
+	}
+	// The stored file is kept rather than replaced
+	if after, _ := os.Stat(path); !os.SameFile(before, after) {
+		t.Error("duplicate Put replaced the stored file")
+	}
+	if names := tempFiles(t, s); len(names) != 0 {
+		t.Errorf("temp files left after a duplicate Put: %v", names)
+	}
+
+	other, _ := s.Put(context.Background(), strings.NewReader("other bytes"))
+	if other.Key == first.Key {
+		t.Fatal("different bytes share a key")
+	}
+}
+
+// failingReader returns some bytes, then an error, like a dropped upload
+type failingReader struct{ sent bool }
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.sent {
+		return 0, io.ErrUnexpectedEOF
+	}
+	r.sent = true
+	return copy(p, "partial image"), nil
+}
+
+func TestPutRemovesTempFileOnFailure(t *testing.T) {
+	s := newTestStore(t)
+
+	if _, err := s.Put(context.Background(), &failingReader{}); err == nil {
+		t.Fatal("Put of a failing reader succeeded")
+	}
+	if names := tempFiles(t, s); len(names) != 0 {
+		t.Fatalf("temp files left after a failed Put: %v", names)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := s.Put(ctx, strings.NewReader("cancelled")); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Put with a cancelled context = %v", err)
+	}
+	if names := tempFiles(t, s); len(names) != 0 {
+		t.Fatalf("temp files left after a cancelled Put: %v", names)
+	}
+
+	// Nothing but the temp directory was created
+	entries, _ := os.ReadDir(s.Root())
+	if len(entries) != 1 {
+		t.Fatalf("store holds %d entries after failed Puts, want only tmp", len(entries))
+	}
+}
+
+func TestMissingBlobs(t *testing.T) {
+	s := newTestStore(t)
+	ctx := context.Background()
+	missing := strings.Repeat("ab", 32)
+
+	for _, key := range []string{missing, "", "../../etc/passwd", strings.Repeat("z", 64)} {
+		if _, err := s.Open(ctx, key); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Open(%q) = %v, want ErrNotFound", key, err)
+		}
+		if _, err := s.Stat(ctx, key); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Stat(%q) = %v, want ErrNotFound", key, err)
+		}
+		if err := s.Delete(ctx, key); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Delete(%q) = %v, want ErrNotFound", key, err)
+		}
+	}
+
+	info, _ := s.Put(ctx, strings.NewReader("to delete"))
+	if err := s.Delete(ctx, info.Key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := s.Open(ctx, info.Key); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Open after Delete = %v, want ErrNotFound", err)
+	}
+}
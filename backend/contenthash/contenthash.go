@@ -0,0 +1,97 @@
+// Package contenthash computes the fingerprints that identify an image.
+// ContentHash is the SHA-256 of the image bytes, as the frontend computes
+// it; Keccak256 is the same bytes under Ethereum's hash, for contracts that
+// prefer it. Both are written as 0x-prefixed lowercase hex, which is also
+// how a bytes32 contentHash is passed to LicenZNFT and LicenZLicense.
+package contenthash
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Algorithm names a supported hash
+type Algorithm string
+
+const (
+	SHA256    Algorithm = "sha256"
+	Keccak256 Algorithm = "keccak256"
+)
+
+// ErrMismatch is returned when a claimed hash matches neither digest
+var ErrMismatch = errors.New("content hash does not match the image bytes")
+
+// Hashes are the digests of one image
+type Hashes struct {
+	SHA256    string
+	Keccak256 string
+}
+
+// Compute hashes data with every supported algorithm
+func Compute(data []byte) Hashes {
+	h := NewHasher()
+	h.Write(data)
+	return h.Sum()
+}
+
+// Hasher computes every digest of a stream in one pass, for uploads too
+// large to hold in memory
+type Hasher struct {
+	sha    hash.Hash
+	keccak hash.Hash
+}
+
+// NewHasher returns an empty Hasher
+func NewHasher() *Hasher {
+	return &Hasher{sha: sha256.New(), keccak: crypto.NewKeccakState()}
+}
+
+// Write adds p to every digest; it never fails
+func (h *Hasher) Write(p []byte) (int, error) {
+	h.sha.Write(p)
+	h.keccak.Write(p)
+	return len(p), nil
+}
+
+// Sum returns the digests of everything written so far
+func (h *Hasher) Sum() Hashes {
+	return Hashes{
+		SHA256:    "0x" + hex.EncodeToString(h.sha.Sum(nil)),
+		Keccak256: "0x" + hex.EncodeToString(h.keccak.Sum(nil)),
+	}
+}
+
+// Verify reports which digest claimed is, or ErrMismatch
+func (h Hashes) Verify(claimed string) (Algorithm, error) {
+	normalized, err := Normalize(claimed)
+	if err != nil {
+		return "", err
+	}
+	switch normalized {
+	case h.SHA256:
+		return SHA256, nil
+	case h.Keccak256:
+		return Keccak256, nil
+	default:
+		return "", ErrMismatch
+	}
+}
+
+// Normalize parses a 32-byte hex hash, with or without a 0x prefix, and
+// returns it in canonical form
+func Normalize(hash string) (string, error) {
+	hash = strings.ToLower(strings.TrimSpace(hash))
+	hash = strings.TrimPrefix(hash, "0x")
+	if len(hash) != 2*sha256.Size {
+		return "", errors.New("content hash must be 32 bytes of hex")
+	}
+	if _, err := hex.DecodeString(hash); err != nil {
+		return "", errors.New("content hash must be 32 bytes of hex")
+	}
+	return "0x" + hash, nil
+}
@@ -0,0 +1,35 @@
+package contenthash
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompute(t *testing.T) {
+	h := Compute([]byte("abc"))
+	if h.SHA256 != "0xba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad" {
+		t.Fatalf("SHA256 = %s", h.SHA256)
+	}
+	if h.Keccak256 != "0x4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45" {
+		t.Fatalf("Keccak256 = %s", h.Keccak256)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	h := Compute([]byte("image bytes"))
+
+	if alg, err := h.Verify(strings.ToUpper(strings.TrimPrefix(h.SHA256, "0x"))); err != nil || alg != SHA256 {
+		t.Fatalf("Verify(sha256) = %s, %v", alg, err)
+	}
+	if alg, err := h.Verify(h.Keccak256); err != nil || alg != Keccak256 {
+		t.Fatalf("Verify(keccak256) = %s, %v", alg, err)
+	}
+	if _, err := h.Verify(Compute([]byte("other")).SHA256); err != ErrMismatch {
+		t.Fatalf("Verify(other) = %v", err)
+	}
+	for _, bad := range []string{"", "0x1234", "0x" + strings.Repeat("zz", 32)} {
+		if _, err := h.Verify(bad); err == nil || err == ErrMismatch {
+			t.Fatalf("Verify(%q) = %v, want a format error", bad, err)
+		}
+	}
+}
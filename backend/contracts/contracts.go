@@ -0,0 +1,13 @@
+// Package contracts holds Go bindings for the LicenZ Solidity contracts in
+// blockchain/contracts. The binding files are generated by abigen; edit the
+// Solidity, recompile with Hardhat and regenerate rather than editing them.
+//
+// abi/ holds the ABIs the bindings were generated from. The Hardhat
+// artifacts are not checked in, so these are maintained by hand from the
+// Solidity sources until go generate replaces them with compiler output.
+// The tests check every function and event against the sources, including
+// return types, mutability, indexed event arguments and the order of struct
+// fields, and against the Hardhat artifacts when those have been built.
+package contracts
+
+//go:generate go run ../scripts/gen-bindings -artifacts ../../blockchain/artifacts/contracts
@@ -0,0 +1,362 @@
+package contracts
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+const (
+	solidityDir = "../../blockchain/contracts"
+	artifactDir = "../../blockchain/artifacts/contracts"
+)
+
+var bindings = map[string]*bind.MetaData{
+	"LicenZContent":       LicenZContentMetaData,
+	"LicenZContentSimple": LicenZContentSimpleMetaData,
+	"LicenZLicense":       LicenZLicenseMetaData,
+	"LicenZNFT":           LicenZNFTMetaData,
+}
+
+// inherited lists the public members each OpenZeppelin 4.9 base contributes
+var inherited = map[string][]string{
+	"ERC721": {
+		"approve(address,uint256)",
+		"balanceOf(address) view returns (uint256)",
+		"getApproved(uint256) view returns (address)",
+		"isApprovedForAll(address,address) view returns (bool)",
+		"name() view returns (string)",
+		"ownerOf(uint256) view returns (address)",
+		"safeTransferFrom(address,address,uint256)",
+		"safeTransferFrom(address,address,uint256,bytes)",
+		"setApprovalForAll(address,bool)",
+		"supportsInterface(bytes4) view returns (bool)",
+		"symbol() view returns (string)",
+		"tokenURI(uint256) view returns (string)",
+		"transferFrom(address,address,uint256)",
+		"event Approval(address indexed,address indexed,uint256 indexed)",
+		"event ApprovalForAll(address indexed,address indexed,bool)",
+		"event Transfer(address indexed,address indexed,uint256 indexed)",
+	},
+	"ERC721URIStorage": {
+		"event BatchMetadataUpdate(uint256,uint256)",
+		"event MetadataUpdate(uint256)",
+	},
+	"Ownable": {
+		"owner() view returns (address)",
+		"renounceOwnership()",
+		"transferOwnership(address)",
+		"event OwnershipTransferred(address indexed,address indexed)",
+	},
+	"ReentrancyGuard": {},
+}
+
+var (
+	lineComment  = regexp.MustCompile(`//[^\n]*`)
+	blockComment = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	contractDecl = regexp.MustCompile(`contract\s+(\w+)(?:\s+is\s+([\w\s,]+))?\s*\{`)
+	structDecl   = regexp.MustCompile(`struct\s+(\w+)\s*\{([^}]*)\}`)
+	functionDecl = regexp.MustCompile(`function\s+(\w+)\s*\(([^)]*)\)([^{;]*)`)
+	returnsDecl  = regexp.MustCompile(`returns\s*\(([^)]*)\)`)
+	eventDecl    = regexp.MustCompile(`event\s+(\w+)\s*\(([^)]*)\)\s*;`)
+	publicVar    = regexp.MustCompile(`(?m)^\s*(mapping\s*\(.*\)|[\w\[\]]+)\s+public\s+(\w+)`)
+	mappingType  = regexp.MustCompile(`^mapping\s*\(\s*(\w+)\s*=>\s*(.*)\)$`)
+)
+
+// member renders a function as the tests compare it: argument types,
+// mutability unless nonpayable, and return types
+func member(name string, inputs []string, mutability string, outputs []string) string {
+	sig := name + "(" + strings.Join(inputs, ",") + ")"
+	if mutability != "" && mutability != "nonpayable" {
+		sig += " " + mutability
+	}
+	if len(outputs) > 0 {
+		sig += " returns (" + strings.Join(outputs, ",") + ")"
+	}
+	return sig
+}
+
+// solidity holds the struct definitions of one source file, which ABI
+// types are resolved against
+type solidity struct {
+	structs map[string][][2]string // name to ordered (type, field name) pairs
+}
+
+// paramTypes reduces a Solidity parameter list to ABI types, keeping the
+// indexed marker of event parameters
+func (s *solidity) paramTypes(params string) []string {
+	var types []string
+	for _, param := range strings.Split(params, ",") {
+		fields := strings.Fields(param)
+		if len(fields) == 0 {
+			continue
+		}
+		t := s.abiType(fields[0])
+		if len(fields) > 1 && fields[1] == "indexed" {
+			t += " indexed"
+		}
+		types = append(types, t)
+	}
+	return types
+}
+
+// abiType spells out Solidity's uint/int aliases as the ABI does, and a
+// struct as a tuple of its named fields in declaration order
+func (s *solidity) abiType(t string) string {
+	base, suffix := t, ""
+	if i := strings.Index(t, "["); i >= 0 {
+		base, suffix = t[:i], t[i:]
+	}
+	switch base {
+	case "uint":
+		base = "uint256"
+	case "int":
+		base = "int256"
+	}
+	if fields, ok := s.structs[base]; ok {
+		var components []string
+		for _, field := range fields {
+			components = append(components, s.abiType(field[0])+" "+field[1])
+		}
+		base = "(" + strings.Join(components, ",") + ")"
+	}
+	return base + suffix
+}
+
+// getter returns the argument and return types of a public state
+// variable's getter. A struct is returned as its fields, less any arrays
+// and mappings.
+func (s *solidity) getter(t string) (inputs, outputs []string) {
+	if m := mappingType.FindStringSubmatch(t); m != nil {
+		inputs, outputs = s.getter(strings.TrimSpace(m[2]))
+		return append([]string{s.abiType(m[1])}, inputs...), outputs
+	}
+	if strings.HasSuffix(t, "[]") {
+		inputs, outputs = s.getter(strings.TrimSuffix(t, "[]"))
+		return append([]string{"uint256"}, inputs...), outputs
+	}
+	fields, ok := s.structs[t]
+	if !ok {
+		return nil, []string{s.abiType(t)}
+	}
+	for _, field := range fields {
+		if strings.HasSuffix(field[0], "]") || strings.HasPrefix(field[0], "mapping") {
+			continue
+		}
+		outputs = append(outputs, s.abiType(field[0]))
+	}
+	return nil, outputs
+}
+
+// mutability picks the state mutability out of a function's modifiers
+func mutability(modifiers []string) string {
+	for _, m := range modifiers {
+		if m == "view" || m == "pure" || m == "payable" {
+			return m
+		}
+	}
+	return "nonpayable"
+}
+
+// soliditySignatures lists the ABI-visible members a contract declares
+// itself, and the bases it inherits from
+func soliditySignatures(t *testing.T, name string) (declared []string, bases []string) {
+	t.Helper()
+	raw, err := os.ReadFile(filepath.Join(solidityDir, name+".sol"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := lineComment.ReplaceAllString(blockComment.ReplaceAllString(string(raw), ""), "")
+
+	decl := contractDecl.FindStringSubmatch(src)
+	if decl == nil || decl[1] != name {
+		t.Fatalf("%s.sol does not declare contract %s", name, name)
+	}
+	for _, base := range strings.Split(decl[2], ",") {
+		if base = strings.TrimSpace(base); base != "" {
+			bases = append(bases, base)
+		}
+	}
+
+	sol := &solidity{structs: make(map[string][][2]string)}
+	for _, m := range structDecl.FindAllStringSubmatch(src, -1) {
+		for _, field := range strings.Split(m[2], ";") {
+			if parts := strings.Fields(field); len(parts) >= 2 {
+				sol.structs[m[1]] = append(sol.structs[m[1]], [2]string{parts[0], parts[len(parts)-1]})
+			}
+		}
+	}
+
+	for _, m := range functionDecl.FindAllStringSubmatch(src, -1) {
+		var outputs []string
+		if r := returnsDecl.FindStringSubmatch(m[3]); r != nil {
+			outputs = sol.paramTypes(r[1])
+		}
+		modifiers := strings.Fields(returnsDecl.ReplaceAllString(m[3], ""))
+		if !contains(modifiers, "public") && !contains(modifiers, "external") {
+			continue
+		}
+		declared = append(declared, member(m[1], sol.paramTypes(m[2]), mutability(modifiers), outputs))
+	}
+	for _, m := range eventDecl.FindAllStringSubmatch(src, -1) {
+		declared = append(declared, "event "+m[1]+"("+strings.Join(sol.paramTypes(m[2]), ",")+")")
+	}
+	for _, m := range publicVar.FindAllStringSubmatch(src, -1) {
+		inputs, outputs := sol.getter(m[1])
+		declared = append(declared, member(m[2], inputs, "view", outputs))
+	}
+	return declared, bases
+}
+
+// abiType renders an ABI type the way solidity.abiType does, naming the
+// components of tuples
+func abiType(t abi.Type) string {
+	switch t.T {
+	case abi.TupleTy:
+		var components []string
+		for i, elem := range t.TupleElems {
+			components = append(components, abiType(*elem)+" "+t.TupleRawNames[i])
+		}
+		return "(" + strings.Join(components, ",") + ")"
+	case abi.SliceTy:
+		return abiType(*t.Elem) + "[]"
+	case abi.ArrayTy:
+		return abiType(*t.Elem) + "[" + strconv.Itoa(t.Size) + "]"
+	}
+	return t.String()
+}
+
+func abiTypes(args abi.Arguments) []string {
+	var types []string
+	for _, arg := range args {
+		t := abiType(arg.Type)
+		if arg.Indexed {
+			t += " indexed"
+		}
+		types = append(types, t)
+	}
+	return types
+}
+
+// abiSignatures lists the methods and events of an ABI, events prefixed
+func abiSignatures(parsed *abi.ABI) []string {
+	var sigs []string
+	for _, method := range parsed.Methods {
+		sigs = append(sigs, member(method.RawName, abiTypes(method.Inputs), method.StateMutability, abiTypes(method.Outputs)))
+	}
+	for _, event := range parsed.Events {
+		sigs = append(sigs, "event "+event.RawName+"("+strings.Join(abiTypes(event.Inputs), ",")+")")
+	}
+	sort.Strings(sigs)
+	return sigs
+}
+
+func readABI(t *testing.T, path string) *abi.ABI {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	parsed, err := abi.JSON(f)
+	if err != nil {
+		t.Fatalf("parse %s: %v", path, err)
+	}
+	return &parsed
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// TestABIMatchesSolidity catches a Solidity change that was not carried
+// over to the hand-maintained ABIs and their bindings
+func TestABIMatchesSolidity(t *testing.T) {
+	for name := range bindings {
+		t.Run(name, func(t *testing.T) {
+			declared, bases := soliditySignatures(t, name)
+			expected := append([]string(nil), declared...)
+			for _, base := range bases {
+				members, ok := inherited[base]
+				if !ok {
+					t.Fatalf("unknown base contract %s; list its public members in inherited", base)
+				}
+				expected = append(expected, members...)
+			}
+
+			got := abiSignatures(readABI(t, filepath.Join("abi", name+".json")))
+			for _, sig := range expected {
+				if !contains(got, sig) {
+					t.Errorf("%s is declared in %s.sol but missing from abi/%s.json", sig, name, name)
+				}
+			}
+			for _, sig := range got {
+				if !contains(expected, sig) {
+					t.Errorf("%s is in abi/%s.json but not declared in %s.sol", sig, name, name)
+				}
+			}
+		})
+	}
+}
+
+// TestBindingsMatchABI catches an ABI that was updated without regenerating
+// its binding
+func TestBindingsMatchABI(t *testing.T) {
+	for name, meta := range bindings {
+		t.Run(name, func(t *testing.T) {
+			bound, err := meta.GetAbi()
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := abiSignatures(readABI(t, filepath.Join("abi", name+".json")))
+			if got := abiSignatures(bound); strings.Join(got, "\n") != strings.Join(want, "\n") {
+				t.Errorf("binding for %s is stale; run go generate ./contracts\nbinding: %v\nabi:     %v", name, got, want)
+			}
+		})
+	}
+}
+
+// TestABIMatchesArtifacts compares the checked-in ABIs with a Hardhat
+// build. It is skipped until the contracts have been compiled.
+func TestABIMatchesArtifacts(t *testing.T) {
+	if _, err := os.Stat(artifactDir); os.IsNotExist(err) {
+		t.Skip("Hardhat artifacts not found; run `npx hardhat compile` in blockchain/")
+	}
+	for name := range bindings {
+		t.Run(name, func(t *testing.T) {
+			raw, err := os.ReadFile(filepath.Join(artifactDir, name+".sol", name+".json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var artifact struct {
+				ABI json.RawMessage `json:"abi"`
+			}
+			if err := json.Unmarshal(raw, &artifact); err != nil {
+				t.Fatal(err)
+			}
+			parsed, err := abi.JSON(bytes.NewReader(artifact.ABI))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := abiSignatures(&parsed)
+			if got := abiSignatures(readABI(t, filepath.Join("abi", name+".json"))); strings.Join(got, "\n") != strings.Join(want, "\n") {
+				t.Errorf("abi/%s.json differs from the compiled contract; run go generate ./contracts\nchecked in: %v\ncompiled:   %v", name, got, want)
+			}
+		})
+	}
+}
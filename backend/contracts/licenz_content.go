@@ -0,0 +1,2225 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// LicenZContentContent is an auto generated low-level Go binding around an user-defined struct.
+type LicenZContentContent struct {
+	Id           *big.Int
+	Creator      common.Address
+	Prompt       string
+	IpfsHash     string
+	Style        string
+	CfgScale     *big.Int
+	Steps        *big.Int
+	Height       *big.Int
+	Width        *big.Int
+	Model        string
+	CreatedAt    *big.Int
+	IsLicensed   bool
+	LicensePrice *big.Int
+	LicenseTerms string
+	Licensee     common.Address
+	LicensedAt   *big.Int
+}
+
+// LicenZContentMetaData contains all meta data concerning the LicenZContent contract.
+var LicenZContentMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"prompt\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"createdAt\",\"type\":\"uint256\"}],\"name\":\"ContentCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"licensee\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"licensedAt\",\"type\":\"uint256\"}],\"name\":\"ContentLicensed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"prompt\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"licenseTerms\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"licensePrice\",\"type\":\"uint256\"}],\"name\":\"ContentUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"contents\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"prompt\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"style\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"cfgScale\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"steps\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"height\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"width\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"model\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"createdAt\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"isLicensed\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"licensePrice\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"licenseTerms\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"licensee\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"licensedAt\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"prompt\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"style\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"cfgScale\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"steps\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"height\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"width\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"model\",\"type\":\"string\"}],\"name\":\"createContent\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"creatorContent\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"creatorContentCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getContent\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"prompt\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"style\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"cfgScale\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"steps\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"height\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"width\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"model\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"createdAt\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"isLicensed\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"licensePrice\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"licenseTerms\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"licensee\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"licensedAt\",\"type\":\"uint256\"}],\"internalType\":\"structLicenZContent.Content\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"}],\"name\":\"getContentByIPFS\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"prompt\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"style\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"cfgScale\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"steps\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"height\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"width\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"model\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"createdAt\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"isLicensed\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"licensePrice\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"licenseTerms\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"licensee\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"licensedAt\",\"type\":\"uint256\"}],\"internalType\":\"structLicenZContent.Content\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"getCreatorContent\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"getCreatorContentCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"}],\"name\":\"getTokenByIPFS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTotalContentCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"name\":\"ipfsToToken\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"}],\"name\":\"isIPFSHashUsed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"purchaseLicense\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"terms\",\"type\":\"string\"}],\"name\":\"setLicense\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"newPrompt\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"newTerms\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"newPrice\",\"type\":\"uint256\"}],\"name\":\"updateContent\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// LicenZContentABI is the input ABI used to generate the binding from.
+// Deprecated: Use LicenZContentMetaData.ABI instead.
+var LicenZContentABI = LicenZContentMetaData.ABI
+
+// LicenZContent is an auto generated Go binding around an Ethereum contract.
+type LicenZContent struct {
+	LicenZContentCaller     // Read-only binding to the contract
+	LicenZContentTransactor // Write-only binding to the contract
+	LicenZContentFilterer   // Log filterer for contract events
+}
+
+// LicenZContentCaller is an auto generated read-only Go binding around an Ethereum contract.
+type LicenZContentCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LicenZContentTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type LicenZContentTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LicenZContentFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type LicenZContentFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LicenZContentSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type LicenZContentSession struct {
+	Contract     *LicenZContent    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// LicenZContentCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type LicenZContentCallerSession struct {
+	Contract *LicenZContentCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// LicenZContentTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type LicenZContentTransactorSession struct {
+	Contract     *LicenZContentTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// LicenZContentRaw is an auto generated low-level Go binding around an Ethereum contract.
+type LicenZContentRaw struct {
+	Contract *LicenZContent // Generic contract binding to access the raw methods on
+}
+
+// LicenZContentCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type LicenZContentCallerRaw struct {
+	Contract *LicenZContentCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// LicenZContentTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type LicenZContentTransactorRaw struct {
+	Contract *LicenZContentTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewLicenZContent creates a new instance of LicenZContent, bound to a specific deployed contract.
+func NewLicenZContent(address common.Address, backend bind.ContractBackend) (*LicenZContent, error) {
+	contract, err := bindLicenZContent(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContent{LicenZContentCaller: LicenZContentCaller{contract: contract}, LicenZContentTransactor: LicenZContentTransactor{contract: contract}, LicenZContentFilterer: LicenZContentFilterer{contract: contract}}, nil
+}
+
+// NewLicenZContentCaller creates a new read-only instance of LicenZContent, bound to a specific deployed contract.
+func NewLicenZContentCaller(address common.Address, caller bind.ContractCaller) (*LicenZContentCaller, error) {
+	contract, err := bindLicenZContent(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentCaller{contract: contract}, nil
+}
+
+// NewLicenZContentTransactor creates a new write-only instance of LicenZContent, bound to a specific deployed contract.
+func NewLicenZContentTransactor(address common.Address, transactor bind.ContractTransactor) (*LicenZContentTransactor, error) {
+	contract, err := bindLicenZContent(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentTransactor{contract: contract}, nil
+}
+
+// NewLicenZContentFilterer creates a new log filterer instance of LicenZContent, bound to a specific deployed contract.
+func NewLicenZContentFilterer(address common.Address, filterer bind.ContractFilterer) (*LicenZContentFilterer, error) {
+	contract, err := bindLicenZContent(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentFilterer{contract: contract}, nil
+}
+
+// bindLicenZContent binds a generic wrapper to an already deployed contract.
+func bindLicenZContent(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := LicenZContentMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LicenZContent *LicenZContentRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LicenZContent.Contract.LicenZContentCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LicenZContent *LicenZContentRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LicenZContent.Contract.LicenZContentTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LicenZContent *LicenZContentRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LicenZContent.Contract.LicenZContentTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LicenZContent *LicenZContentCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LicenZContent.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LicenZContent *LicenZContentTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LicenZContent.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LicenZContent *LicenZContentTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LicenZContent.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LicenZContent *LicenZContentCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LicenZContent *LicenZContentSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _LicenZContent.Contract.BalanceOf(&_LicenZContent.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LicenZContent *LicenZContentCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _LicenZContent.Contract.BalanceOf(&_LicenZContent.CallOpts, owner)
+}
+
+// Contents is a free data retrieval call binding the contract method 0xb5ecf912.
+//
+// Solidity: function contents(uint256 ) view returns(uint256 id, address creator, string prompt, string ipfsHash, string style, uint256 cfgScale, uint256 steps, uint256 height, uint256 width, string model, uint256 createdAt, bool isLicensed, uint256 licensePrice, string licenseTerms, address licensee, uint256 licensedAt)
+func (_LicenZContent *LicenZContentCaller) Contents(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	Id           *big.Int
+	Creator      common.Address
+	Prompt       string
+	IpfsHash     string
+	Style        string
+	CfgScale     *big.Int
+	Steps        *big.Int
+	Height       *big.Int
+	Width        *big.Int
+	Model        string
+	CreatedAt    *big.Int
+	IsLicensed   bool
+	LicensePrice *big.Int
+	LicenseTerms string
+	Licensee     common.Address
+	LicensedAt   *big.Int
+}, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "contents", arg0)
+
+	outstruct := new(struct {
+		Id           *big.Int
+		Creator      common.Address
+		Prompt       string
+		IpfsHash     string
+		Style        string
+		CfgScale     *big.Int
+		Steps        *big.Int
+		Height       *big.Int
+		Width        *big.Int
+		Model        string
+		CreatedAt    *big.Int
+		IsLicensed   bool
+		LicensePrice *big.Int
+		LicenseTerms string
+		Licensee     common.Address
+		LicensedAt   *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Id = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Creator = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.Prompt = *abi.ConvertType(out[2], new(string)).(*string)
+	outstruct.IpfsHash = *abi.ConvertType(out[3], new(string)).(*string)
+	outstruct.Style = *abi.ConvertType(out[4], new(string)).(*string)
+	outstruct.CfgScale = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+	outstruct.Steps = *abi.ConvertType(out[6], new(*big.Int)).(**big.Int)
+	outstruct.Height = *abi.ConvertType(out[7], new(*big.Int)).(**big.Int)
+	outstruct.Width = *abi.ConvertType(out[8], new(*big.Int)).(**big.Int)
+	outstruct.Model = *abi.ConvertType(out[9], new(string)).(*string)
+	outstruct.CreatedAt = *abi.ConvertType(out[10], new(*big.Int)).(**big.Int)
+	outstruct.IsLicensed = *abi.ConvertType(out[11], new(bool)).(*bool)
+	outstruct.LicensePrice = *abi.ConvertType(out[12], new(*big.Int)).(**big.Int)
+	outstruct.LicenseTerms = *abi.ConvertType(out[13], new(string)).(*string)
+	outstruct.Licensee = *abi.ConvertType(out[14], new(common.Address)).(*common.Address)
+	outstruct.LicensedAt = *abi.ConvertType(out[15], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Contents is a free data retrieval call binding the contract method 0xb5ecf912.
+//
+// Solidity: function contents(uint256 ) view returns(uint256 id, address creator, string prompt, string ipfsHash, string style, uint256 cfgScale, uint256 steps, uint256 height, uint256 width, string model, uint256 createdAt, bool isLicensed, uint256 licensePrice, string licenseTerms, address licensee, uint256 licensedAt)
+func (_LicenZContent *LicenZContentSession) Contents(arg0 *big.Int) (struct {
+	Id           *big.Int
+	Creator      common.Address
+	Prompt       string
+	IpfsHash     string
+	Style        string
+	CfgScale     *big.Int
+	Steps        *big.Int
+	Height       *big.Int
+	Width        *big.Int
+	Model        string
+	CreatedAt    *big.Int
+	IsLicensed   bool
+	LicensePrice *big.Int
+	LicenseTerms string
+	Licensee     common.Address
+	LicensedAt   *big.Int
+}, error) {
+	return _LicenZContent.Contract.Contents(&_LicenZContent.CallOpts, arg0)
+}
+
+// Contents is a free data retrieval call binding the contract method 0xb5ecf912.
+//
+// Solidity: function contents(uint256 ) view returns(uint256 id, address creator, string prompt, string ipfsHash, string style, uint256 cfgScale, uint256 steps, uint256 height, uint256 width, string model, uint256 createdAt, bool isLicensed, uint256 licensePrice, string licenseTerms, address licensee, uint256 licensedAt)
+func (_LicenZContent *LicenZContentCallerSession) Contents(arg0 *big.Int) (struct {
+	Id           *big.Int
+	Creator      common.Address
+	Prompt       string
+	IpfsHash     string
+	Style        string
+	CfgScale     *big.Int
+	Steps        *big.Int
+	Height       *big.Int
+	Width        *big.Int
+	Model        string
+	CreatedAt    *big.Int
+	IsLicensed   bool
+	LicensePrice *big.Int
+	LicenseTerms string
+	Licensee     common.Address
+	LicensedAt   *big.Int
+}, error) {
+	return _LicenZContent.Contract.Contents(&_LicenZContent.CallOpts, arg0)
+}
+
+// CreatorContent is a free data retrieval call binding the contract method 0x6a480eb8.
+//
+// Solidity: function creatorContent(address , uint256 ) view returns(uint256)
+func (_LicenZContent *LicenZContentCaller) CreatorContent(opts *bind.CallOpts, arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "creatorContent", arg0, arg1)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CreatorContent is a free data retrieval call binding the contract method 0x6a480eb8.
+//
+// Solidity: function creatorContent(address , uint256 ) view returns(uint256)
+func (_LicenZContent *LicenZContentSession) CreatorContent(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _LicenZContent.Contract.CreatorContent(&_LicenZContent.CallOpts, arg0, arg1)
+}
+
+// CreatorContent is a free data retrieval call binding the contract method 0x6a480eb8.
+//
+// Solidity: function creatorContent(address , uint256 ) view returns(uint256)
+func (_LicenZContent *LicenZContentCallerSession) CreatorContent(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _LicenZContent.Contract.CreatorContent(&_LicenZContent.CallOpts, arg0, arg1)
+}
+
+// CreatorContentCount is a free data retrieval call binding the contract method 0xd92b58fd.
+//
+// Solidity: function creatorContentCount(address ) view returns(uint256)
+func (_LicenZContent *LicenZContentCaller) CreatorContentCount(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "creatorContentCount", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CreatorContentCount is a free data retrieval call binding the contract method 0xd92b58fd.
+//
+// Solidity: function creatorContentCount(address ) view returns(uint256)
+func (_LicenZContent *LicenZContentSession) CreatorContentCount(arg0 common.Address) (*big.Int, error) {
+	return _LicenZContent.Contract.CreatorContentCount(&_LicenZContent.CallOpts, arg0)
+}
+
+// CreatorContentCount is a free data retrieval call binding the contract method 0xd92b58fd.
+//
+// Solidity: function creatorContentCount(address ) view returns(uint256)
+func (_LicenZContent *LicenZContentCallerSession) CreatorContentCount(arg0 common.Address) (*big.Int, error) {
+	return _LicenZContent.Contract.CreatorContentCount(&_LicenZContent.CallOpts, arg0)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LicenZContent *LicenZContentCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LicenZContent *LicenZContentSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _LicenZContent.Contract.GetApproved(&_LicenZContent.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LicenZContent *LicenZContentCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _LicenZContent.Contract.GetApproved(&_LicenZContent.CallOpts, tokenId)
+}
+
+// GetContent is a free data retrieval call binding the contract method 0x0b7ad54c.
+//
+// Solidity: function getContent(uint256 tokenId) view returns((uint256,address,string,string,string,uint256,uint256,uint256,uint256,string,uint256,bool,uint256,string,address,uint256))
+func (_LicenZContent *LicenZContentCaller) GetContent(opts *bind.CallOpts, tokenId *big.Int) (LicenZContentContent, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "getContent", tokenId)
+
+	if err != nil {
+		return *new(LicenZContentContent), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(LicenZContentContent)).(*LicenZContentContent)
+
+	return out0, err
+
+}
+
+// GetContent is a free data retrieval call binding the contract method 0x0b7ad54c.
+//
+// Solidity: function getContent(uint256 tokenId) view returns((uint256,address,string,string,string,uint256,uint256,uint256,uint256,string,uint256,bool,uint256,string,address,uint256))
+func (_LicenZContent *LicenZContentSession) GetContent(tokenId *big.Int) (LicenZContentContent, error) {
+	return _LicenZContent.Contract.GetContent(&_LicenZContent.CallOpts, tokenId)
+}
+
+// GetContent is a free data retrieval call binding the contract method 0x0b7ad54c.
+//
+// Solidity: function getContent(uint256 tokenId) view returns((uint256,address,string,string,string,uint256,uint256,uint256,uint256,string,uint256,bool,uint256,string,address,uint256))
+func (_LicenZContent *LicenZContentCallerSession) GetContent(tokenId *big.Int) (LicenZContentContent, error) {
+	return _LicenZContent.Contract.GetContent(&_LicenZContent.CallOpts, tokenId)
+}
+
+// GetContentByIPFS is a free data retrieval call binding the contract method 0x557ee27a.
+//
+// Solidity: function getContentByIPFS(string ipfsHash) view returns((uint256,address,string,string,string,uint256,uint256,uint256,uint256,string,uint256,bool,uint256,string,address,uint256))
+func (_LicenZContent *LicenZContentCaller) GetContentByIPFS(opts *bind.CallOpts, ipfsHash string) (LicenZContentContent, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "getContentByIPFS", ipfsHash)
+
+	if err != nil {
+		return *new(LicenZContentContent), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(LicenZContentContent)).(*LicenZContentContent)
+
+	return out0, err
+
+}
+
+// GetContentByIPFS is a free data retrieval call binding the contract method 0x557ee27a.
+//
+// Solidity: function getContentByIPFS(string ipfsHash) view returns((uint256,address,string,string,string,uint256,uint256,uint256,uint256,string,uint256,bool,uint256,string,address,uint256))
+func (_LicenZContent *LicenZContentSession) GetContentByIPFS(ipfsHash string) (LicenZContentContent, error) {
+	return _LicenZContent.Contract.GetContentByIPFS(&_LicenZContent.CallOpts, ipfsHash)
+}
+
+// GetContentByIPFS is a free data retrieval call binding the contract method 0x557ee27a.
+//
+// Solidity: function getContentByIPFS(string ipfsHash) view returns((uint256,address,string,string,string,uint256,uint256,uint256,uint256,string,uint256,bool,uint256,string,address,uint256))
+func (_LicenZContent *LicenZContentCallerSession) GetContentByIPFS(ipfsHash string) (LicenZContentContent, error) {
+	return _LicenZContent.Contract.GetContentByIPFS(&_LicenZContent.CallOpts, ipfsHash)
+}
+
+// GetCreatorContent is a free data retrieval call binding the contract method 0x011c6756.
+//
+// Solidity: function getCreatorContent(address creator) view returns(uint256[])
+func (_LicenZContent *LicenZContentCaller) GetCreatorContent(opts *bind.CallOpts, creator common.Address) ([]*big.Int, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "getCreatorContent", creator)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetCreatorContent is a free data retrieval call binding the contract method 0x011c6756.
+//
+// Solidity: function getCreatorContent(address creator) view returns(uint256[])
+func (_LicenZContent *LicenZContentSession) GetCreatorContent(creator common.Address) ([]*big.Int, error) {
+	return _LicenZContent.Contract.GetCreatorContent(&_LicenZContent.CallOpts, creator)
+}
+
+// GetCreatorContent is a free data retrieval call binding the contract method 0x011c6756.
+//
+// Solidity: function getCreatorContent(address creator) view returns(uint256[])
+func (_LicenZContent *LicenZContentCallerSession) GetCreatorContent(creator common.Address) ([]*big.Int, error) {
+	return _LicenZContent.Contract.GetCreatorContent(&_LicenZContent.CallOpts, creator)
+}
+
+// GetCreatorContentCount is a free data retrieval call binding the contract method 0xd6e429e5.
+//
+// Solidity: function getCreatorContentCount(address creator) view returns(uint256)
+func (_LicenZContent *LicenZContentCaller) GetCreatorContentCount(opts *bind.CallOpts, creator common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "getCreatorContentCount", creator)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCreatorContentCount is a free data retrieval call binding the contract method 0xd6e429e5.
+//
+// Solidity: function getCreatorContentCount(address creator) view returns(uint256)
+func (_LicenZContent *LicenZContentSession) GetCreatorContentCount(creator common.Address) (*big.Int, error) {
+	return _LicenZContent.Contract.GetCreatorContentCount(&_LicenZContent.CallOpts, creator)
+}
+
+// GetCreatorContentCount is a free data retrieval call binding the contract method 0xd6e429e5.
+//
+// Solidity: function getCreatorContentCount(address creator) view returns(uint256)
+func (_LicenZContent *LicenZContentCallerSession) GetCreatorContentCount(creator common.Address) (*big.Int, error) {
+	return _LicenZContent.Contract.GetCreatorContentCount(&_LicenZContent.CallOpts, creator)
+}
+
+// GetTokenByIPFS is a free data retrieval call binding the contract method 0x79295d37.
+//
+// Solidity: function getTokenByIPFS(string ipfsHash) view returns(uint256)
+func (_LicenZContent *LicenZContentCaller) GetTokenByIPFS(opts *bind.CallOpts, ipfsHash string) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "getTokenByIPFS", ipfsHash)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTokenByIPFS is a free data retrieval call binding the contract method 0x79295d37.
+//
+// Solidity: function getTokenByIPFS(string ipfsHash) view returns(uint256)
+func (_LicenZContent *LicenZContentSession) GetTokenByIPFS(ipfsHash string) (*big.Int, error) {
+	return _LicenZContent.Contract.GetTokenByIPFS(&_LicenZContent.CallOpts, ipfsHash)
+}
+
+// GetTokenByIPFS is a free data retrieval call binding the contract method 0x79295d37.
+//
+// Solidity: function getTokenByIPFS(string ipfsHash) view returns(uint256)
+func (_LicenZContent *LicenZContentCallerSession) GetTokenByIPFS(ipfsHash string) (*big.Int, error) {
+	return _LicenZContent.Contract.GetTokenByIPFS(&_LicenZContent.CallOpts, ipfsHash)
+}
+
+// GetTotalContentCount is a free data retrieval call binding the contract method 0x31d95dd0.
+//
+// Solidity: function getTotalContentCount() view returns(uint256)
+func (_LicenZContent *LicenZContentCaller) GetTotalContentCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "getTotalContentCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTotalContentCount is a free data retrieval call binding the contract method 0x31d95dd0.
+//
+// Solidity: function getTotalContentCount() view returns(uint256)
+func (_LicenZContent *LicenZContentSession) GetTotalContentCount() (*big.Int, error) {
+	return _LicenZContent.Contract.GetTotalContentCount(&_LicenZContent.CallOpts)
+}
+
+// GetTotalContentCount is a free data retrieval call binding the contract method 0x31d95dd0.
+//
+// Solidity: function getTotalContentCount() view returns(uint256)
+func (_LicenZContent *LicenZContentCallerSession) GetTotalContentCount() (*big.Int, error) {
+	return _LicenZContent.Contract.GetTotalContentCount(&_LicenZContent.CallOpts)
+}
+
+// IpfsToToken is a free data retrieval call binding the contract method 0xd4188b0a.
+//
+// Solidity: function ipfsToToken(string ) view returns(uint256)
+func (_LicenZContent *LicenZContentCaller) IpfsToToken(opts *bind.CallOpts, arg0 string) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "ipfsToToken", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// IpfsToToken is a free data retrieval call binding the contract method 0xd4188b0a.
+//
+// Solidity: function ipfsToToken(string ) view returns(uint256)
+func (_LicenZContent *LicenZContentSession) IpfsToToken(arg0 string) (*big.Int, error) {
+	return _LicenZContent.Contract.IpfsToToken(&_LicenZContent.CallOpts, arg0)
+}
+
+// IpfsToToken is a free data retrieval call binding the contract method 0xd4188b0a.
+//
+// Solidity: function ipfsToToken(string ) view returns(uint256)
+func (_LicenZContent *LicenZContentCallerSession) IpfsToToken(arg0 string) (*big.Int, error) {
+	return _LicenZContent.Contract.IpfsToToken(&_LicenZContent.CallOpts, arg0)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LicenZContent *LicenZContentCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LicenZContent *LicenZContentSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _LicenZContent.Contract.IsApprovedForAll(&_LicenZContent.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LicenZContent *LicenZContentCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _LicenZContent.Contract.IsApprovedForAll(&_LicenZContent.CallOpts, owner, operator)
+}
+
+// IsIPFSHashUsed is a free data retrieval call binding the contract method 0xe968e83d.
+//
+// Solidity: function isIPFSHashUsed(string ipfsHash) view returns(bool)
+func (_LicenZContent *LicenZContentCaller) IsIPFSHashUsed(opts *bind.CallOpts, ipfsHash string) (bool, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "isIPFSHashUsed", ipfsHash)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsIPFSHashUsed is a free data retrieval call binding the contract method 0xe968e83d.
+//
+// Solidity: function isIPFSHashUsed(string ipfsHash) view returns(bool)
+func (_LicenZContent *LicenZContentSession) IsIPFSHashUsed(ipfsHash string) (bool, error) {
+	return _LicenZContent.Contract.IsIPFSHashUsed(&_LicenZContent.CallOpts, ipfsHash)
+}
+
+// IsIPFSHashUsed is a free data retrieval call binding the contract method 0xe968e83d.
+//
+// Solidity: function isIPFSHashUsed(string ipfsHash) view returns(bool)
+func (_LicenZContent *LicenZContentCallerSession) IsIPFSHashUsed(ipfsHash string) (bool, error) {
+	return _LicenZContent.Contract.IsIPFSHashUsed(&_LicenZContent.CallOpts, ipfsHash)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LicenZContent *LicenZContentCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LicenZContent *LicenZContentSession) Name() (string, error) {
+	return _LicenZContent.Contract.Name(&_LicenZContent.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LicenZContent *LicenZContentCallerSession) Name() (string, error) {
+	return _LicenZContent.Contract.Name(&_LicenZContent.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LicenZContent *LicenZContentCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LicenZContent *LicenZContentSession) Owner() (common.Address, error) {
+	return _LicenZContent.Contract.Owner(&_LicenZContent.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LicenZContent *LicenZContentCallerSession) Owner() (common.Address, error) {
+	return _LicenZContent.Contract.Owner(&_LicenZContent.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LicenZContent *LicenZContentCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LicenZContent *LicenZContentSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _LicenZContent.Contract.OwnerOf(&_LicenZContent.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LicenZContent *LicenZContentCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _LicenZContent.Contract.OwnerOf(&_LicenZContent.CallOpts, tokenId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LicenZContent *LicenZContentCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LicenZContent *LicenZContentSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _LicenZContent.Contract.SupportsInterface(&_LicenZContent.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LicenZContent *LicenZContentCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _LicenZContent.Contract.SupportsInterface(&_LicenZContent.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LicenZContent *LicenZContentCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LicenZContent *LicenZContentSession) Symbol() (string, error) {
+	return _LicenZContent.Contract.Symbol(&_LicenZContent.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LicenZContent *LicenZContentCallerSession) Symbol() (string, error) {
+	return _LicenZContent.Contract.Symbol(&_LicenZContent.CallOpts)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LicenZContent *LicenZContentCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _LicenZContent.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LicenZContent *LicenZContentSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _LicenZContent.Contract.TokenURI(&_LicenZContent.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LicenZContent *LicenZContentCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _LicenZContent.Contract.TokenURI(&_LicenZContent.CallOpts, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LicenZContent *LicenZContentTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LicenZContent *LicenZContentSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.Contract.Approve(&_LicenZContent.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LicenZContent *LicenZContentTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.Contract.Approve(&_LicenZContent.TransactOpts, to, tokenId)
+}
+
+// CreateContent is a paid mutator transaction binding the contract method 0x910ab01a.
+//
+// Solidity: function createContent(string prompt, string ipfsHash, string style, uint256 cfgScale, uint256 steps, uint256 height, uint256 width, string model) returns(uint256)
+func (_LicenZContent *LicenZContentTransactor) CreateContent(opts *bind.TransactOpts, prompt string, ipfsHash string, style string, cfgScale *big.Int, steps *big.Int, height *big.Int, width *big.Int, model string) (*types.Transaction, error) {
+	return _LicenZContent.contract.Transact(opts, "createContent", prompt, ipfsHash, style, cfgScale, steps, height, width, model)
+}
+
+// CreateContent is a paid mutator transaction binding the contract method 0x910ab01a.
+//
+// Solidity: function createContent(string prompt, string ipfsHash, string style, uint256 cfgScale, uint256 steps, uint256 height, uint256 width, string model) returns(uint256)
+func (_LicenZContent *LicenZContentSession) CreateContent(prompt string, ipfsHash string, style string, cfgScale *big.Int, steps *big.Int, height *big.Int, width *big.Int, model string) (*types.Transaction, error) {
+	return _LicenZContent.Contract.CreateContent(&_LicenZContent.TransactOpts, prompt, ipfsHash, style, cfgScale, steps, height, width, model)
+}
+
+// CreateContent is a paid mutator transaction binding the contract method 0x910ab01a.
+//
+// Solidity: function createContent(string prompt, string ipfsHash, string style, uint256 cfgScale, uint256 steps, uint256 height, uint256 width, string model) returns(uint256)
+func (_LicenZContent *LicenZContentTransactorSession) CreateContent(prompt string, ipfsHash string, style string, cfgScale *big.Int, steps *big.Int, height *big.Int, width *big.Int, model string) (*types.Transaction, error) {
+	return _LicenZContent.Contract.CreateContent(&_LicenZContent.TransactOpts, prompt, ipfsHash, style, cfgScale, steps, height, width, model)
+}
+
+// PurchaseLicense is a paid mutator transaction binding the contract method 0xc8a028a8.
+//
+// Solidity: function purchaseLicense(uint256 tokenId) payable returns()
+func (_LicenZContent *LicenZContentTransactor) PurchaseLicense(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.contract.Transact(opts, "purchaseLicense", tokenId)
+}
+
+// PurchaseLicense is a paid mutator transaction binding the contract method 0xc8a028a8.
+//
+// Solidity: function purchaseLicense(uint256 tokenId) payable returns()
+func (_LicenZContent *LicenZContentSession) PurchaseLicense(tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.Contract.PurchaseLicense(&_LicenZContent.TransactOpts, tokenId)
+}
+
+// PurchaseLicense is a paid mutator transaction binding the contract method 0xc8a028a8.
+//
+// Solidity: function purchaseLicense(uint256 tokenId) payable returns()
+func (_LicenZContent *LicenZContentTransactorSession) PurchaseLicense(tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.Contract.PurchaseLicense(&_LicenZContent.TransactOpts, tokenId)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LicenZContent *LicenZContentTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LicenZContent.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LicenZContent *LicenZContentSession) RenounceOwnership() (*types.Transaction, error) {
+	return _LicenZContent.Contract.RenounceOwnership(&_LicenZContent.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LicenZContent *LicenZContentTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _LicenZContent.Contract.RenounceOwnership(&_LicenZContent.TransactOpts)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LicenZContent *LicenZContentTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LicenZContent *LicenZContentSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.Contract.SafeTransferFrom(&_LicenZContent.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LicenZContent *LicenZContentTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.Contract.SafeTransferFrom(&_LicenZContent.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LicenZContent *LicenZContentTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LicenZContent.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LicenZContent *LicenZContentSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LicenZContent.Contract.SafeTransferFrom0(&_LicenZContent.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LicenZContent *LicenZContentTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LicenZContent.Contract.SafeTransferFrom0(&_LicenZContent.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LicenZContent *LicenZContentTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LicenZContent.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LicenZContent *LicenZContentSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LicenZContent.Contract.SetApprovalForAll(&_LicenZContent.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LicenZContent *LicenZContentTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LicenZContent.Contract.SetApprovalForAll(&_LicenZContent.TransactOpts, operator, approved)
+}
+
+// SetLicense is a paid mutator transaction binding the contract method 0x1098ab17.
+//
+// Solidity: function setLicense(uint256 tokenId, uint256 price, string terms) returns()
+func (_LicenZContent *LicenZContentTransactor) SetLicense(opts *bind.TransactOpts, tokenId *big.Int, price *big.Int, terms string) (*types.Transaction, error) {
+	return _LicenZContent.contract.Transact(opts, "setLicense", tokenId, price, terms)
+}
+
+// SetLicense is a paid mutator transaction binding the contract method 0x1098ab17.
+//
+// Solidity: function setLicense(uint256 tokenId, uint256 price, string terms) returns()
+func (_LicenZContent *LicenZContentSession) SetLicense(tokenId *big.Int, price *big.Int, terms string) (*types.Transaction, error) {
+	return _LicenZContent.Contract.SetLicense(&_LicenZContent.TransactOpts, tokenId, price, terms)
+}
+
+// SetLicense is a paid mutator transaction binding the contract method 0x1098ab17.
+//
+// Solidity: function setLicense(uint256 tokenId, uint256 price, string terms) returns()
+func (_LicenZContent *LicenZContentTransactorSession) SetLicense(tokenId *big.Int, price *big.Int, terms string) (*types.Transaction, error) {
+	return _LicenZContent.Contract.SetLicense(&_LicenZContent.TransactOpts, tokenId, price, terms)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LicenZContent *LicenZContentTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LicenZContent *LicenZContentSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.Contract.TransferFrom(&_LicenZContent.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LicenZContent *LicenZContentTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.Contract.TransferFrom(&_LicenZContent.TransactOpts, from, to, tokenId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LicenZContent *LicenZContentTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _LicenZContent.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LicenZContent *LicenZContentSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LicenZContent.Contract.TransferOwnership(&_LicenZContent.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LicenZContent *LicenZContentTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LicenZContent.Contract.TransferOwnership(&_LicenZContent.TransactOpts, newOwner)
+}
+
+// UpdateContent is a paid mutator transaction binding the contract method 0x58701682.
+//
+// Solidity: function updateContent(uint256 tokenId, string newPrompt, string newTerms, uint256 newPrice) returns()
+func (_LicenZContent *LicenZContentTransactor) UpdateContent(opts *bind.TransactOpts, tokenId *big.Int, newPrompt string, newTerms string, newPrice *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.contract.Transact(opts, "updateContent", tokenId, newPrompt, newTerms, newPrice)
+}
+
+// UpdateContent is a paid mutator transaction binding the contract method 0x58701682.
+//
+// Solidity: function updateContent(uint256 tokenId, string newPrompt, string newTerms, uint256 newPrice) returns()
+func (_LicenZContent *LicenZContentSession) UpdateContent(tokenId *big.Int, newPrompt string, newTerms string, newPrice *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.Contract.UpdateContent(&_LicenZContent.TransactOpts, tokenId, newPrompt, newTerms, newPrice)
+}
+
+// UpdateContent is a paid mutator transaction binding the contract method 0x58701682.
+//
+// Solidity: function updateContent(uint256 tokenId, string newPrompt, string newTerms, uint256 newPrice) returns()
+func (_LicenZContent *LicenZContentTransactorSession) UpdateContent(tokenId *big.Int, newPrompt string, newTerms string, newPrice *big.Int) (*types.Transaction, error) {
+	return _LicenZContent.Contract.UpdateContent(&_LicenZContent.TransactOpts, tokenId, newPrompt, newTerms, newPrice)
+}
+
+// LicenZContentApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the LicenZContent contract.
+type LicenZContentApprovalIterator struct {
+	Event *LicenZContentApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZContentApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZContentApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZContentApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZContentApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZContentApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZContentApproval represents a Approval event raised by the LicenZContent contract.
+type LicenZContentApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LicenZContent *LicenZContentFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*LicenZContentApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LicenZContent.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentApprovalIterator{contract: _LicenZContent.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LicenZContent *LicenZContentFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *LicenZContentApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LicenZContent.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZContentApproval)
+				if err := _LicenZContent.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LicenZContent *LicenZContentFilterer) ParseApproval(log types.Log) (*LicenZContentApproval, error) {
+	event := new(LicenZContentApproval)
+	if err := _LicenZContent.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZContentApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the LicenZContent contract.
+type LicenZContentApprovalForAllIterator struct {
+	Event *LicenZContentApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZContentApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZContentApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZContentApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZContentApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZContentApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZContentApprovalForAll represents a ApprovalForAll event raised by the LicenZContent contract.
+type LicenZContentApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LicenZContent *LicenZContentFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*LicenZContentApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LicenZContent.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentApprovalForAllIterator{contract: _LicenZContent.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LicenZContent *LicenZContentFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *LicenZContentApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LicenZContent.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZContentApprovalForAll)
+				if err := _LicenZContent.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LicenZContent *LicenZContentFilterer) ParseApprovalForAll(log types.Log) (*LicenZContentApprovalForAll, error) {
+	event := new(LicenZContentApprovalForAll)
+	if err := _LicenZContent.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZContentContentCreatedIterator is returned from FilterContentCreated and is used to iterate over the raw logs and unpacked data for ContentCreated events raised by the LicenZContent contract.
+type LicenZContentContentCreatedIterator struct {
+	Event *LicenZContentContentCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZContentContentCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZContentContentCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZContentContentCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZContentContentCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZContentContentCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZContentContentCreated represents a ContentCreated event raised by the LicenZContent contract.
+type LicenZContentContentCreated struct {
+	TokenId   *big.Int
+	Creator   common.Address
+	Prompt    string
+	IpfsHash  string
+	CreatedAt *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterContentCreated is a free log retrieval operation binding the contract event 0x30e02d5244771b6f1d783570253a777f1e5461226589818b7f5de028e0cb83cf.
+//
+// Solidity: event ContentCreated(uint256 indexed tokenId, address indexed creator, string prompt, string ipfsHash, uint256 createdAt)
+func (_LicenZContent *LicenZContentFilterer) FilterContentCreated(opts *bind.FilterOpts, tokenId []*big.Int, creator []common.Address) (*LicenZContentContentCreatedIterator, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _LicenZContent.contract.FilterLogs(opts, "ContentCreated", tokenIdRule, creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentContentCreatedIterator{contract: _LicenZContent.contract, event: "ContentCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchContentCreated is a free log subscription operation binding the contract event 0x30e02d5244771b6f1d783570253a777f1e5461226589818b7f5de028e0cb83cf.
+//
+// Solidity: event ContentCreated(uint256 indexed tokenId, address indexed creator, string prompt, string ipfsHash, uint256 createdAt)
+func (_LicenZContent *LicenZContentFilterer) WatchContentCreated(opts *bind.WatchOpts, sink chan<- *LicenZContentContentCreated, tokenId []*big.Int, creator []common.Address) (event.Subscription, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _LicenZContent.contract.WatchLogs(opts, "ContentCreated", tokenIdRule, creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZContentContentCreated)
+				if err := _LicenZContent.contract.UnpackLog(event, "ContentCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContentCreated is a log parse operation binding the contract event 0x30e02d5244771b6f1d783570253a777f1e5461226589818b7f5de028e0cb83cf.
+//
+// Solidity: event ContentCreated(uint256 indexed tokenId, address indexed creator, string prompt, string ipfsHash, uint256 createdAt)
+func (_LicenZContent *LicenZContentFilterer) ParseContentCreated(log types.Log) (*LicenZContentContentCreated, error) {
+	event := new(LicenZContentContentCreated)
+	if err := _LicenZContent.contract.UnpackLog(event, "ContentCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZContentContentLicensedIterator is returned from FilterContentLicensed and is used to iterate over the raw logs and unpacked data for ContentLicensed events raised by the LicenZContent contract.
+type LicenZContentContentLicensedIterator struct {
+	Event *LicenZContentContentLicensed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZContentContentLicensedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZContentContentLicensed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZContentContentLicensed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZContentContentLicensedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZContentContentLicensedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZContentContentLicensed represents a ContentLicensed event raised by the LicenZContent contract.
+type LicenZContentContentLicensed struct {
+	TokenId    *big.Int
+	Licensee   common.Address
+	Price      *big.Int
+	LicensedAt *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterContentLicensed is a free log retrieval operation binding the contract event 0x31fe1db016b6093ec812da5d679f33de6082b3a13915b5e2b5c41a8f3a81ba17.
+//
+// Solidity: event ContentLicensed(uint256 indexed tokenId, address indexed licensee, uint256 price, uint256 licensedAt)
+func (_LicenZContent *LicenZContentFilterer) FilterContentLicensed(opts *bind.FilterOpts, tokenId []*big.Int, licensee []common.Address) (*LicenZContentContentLicensedIterator, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+	var licenseeRule []interface{}
+	for _, licenseeItem := range licensee {
+		licenseeRule = append(licenseeRule, licenseeItem)
+	}
+
+	logs, sub, err := _LicenZContent.contract.FilterLogs(opts, "ContentLicensed", tokenIdRule, licenseeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentContentLicensedIterator{contract: _LicenZContent.contract, event: "ContentLicensed", logs: logs, sub: sub}, nil
+}
+
+// WatchContentLicensed is a free log subscription operation binding the contract event 0x31fe1db016b6093ec812da5d679f33de6082b3a13915b5e2b5c41a8f3a81ba17.
+//
+// Solidity: event ContentLicensed(uint256 indexed tokenId, address indexed licensee, uint256 price, uint256 licensedAt)
+func (_LicenZContent *LicenZContentFilterer) WatchContentLicensed(opts *bind.WatchOpts, sink chan<- *LicenZContentContentLicensed, tokenId []*big.Int, licensee []common.Address) (event.Subscription, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+	var licenseeRule []interface{}
+	for _, licenseeItem := range licensee {
+		licenseeRule = append(licenseeRule, licenseeItem)
+	}
+
+	logs, sub, err := _LicenZContent.contract.WatchLogs(opts, "ContentLicensed", tokenIdRule, licenseeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZContentContentLicensed)
+				if err := _LicenZContent.contract.UnpackLog(event, "ContentLicensed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContentLicensed is a log parse operation binding the contract event 0x31fe1db016b6093ec812da5d679f33de6082b3a13915b5e2b5c41a8f3a81ba17.
+//
+// Solidity: event ContentLicensed(uint256 indexed tokenId, address indexed licensee, uint256 price, uint256 licensedAt)
+func (_LicenZContent *LicenZContentFilterer) ParseContentLicensed(log types.Log) (*LicenZContentContentLicensed, error) {
+	event := new(LicenZContentContentLicensed)
+	if err := _LicenZContent.contract.UnpackLog(event, "ContentLicensed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZContentContentUpdatedIterator is returned from FilterContentUpdated and is used to iterate over the raw logs and unpacked data for ContentUpdated events raised by the LicenZContent contract.
+type LicenZContentContentUpdatedIterator struct {
+	Event *LicenZContentContentUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZContentContentUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZContentContentUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZContentContentUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZContentContentUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZContentContentUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZContentContentUpdated represents a ContentUpdated event raised by the LicenZContent contract.
+type LicenZContentContentUpdated struct {
+	TokenId      *big.Int
+	Prompt       string
+	LicenseTerms string
+	LicensePrice *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterContentUpdated is a free log retrieval operation binding the contract event 0x646810720d040db259fc72fe01b4d52d25284365fa35cc4817f84a84c719a03e.
+//
+// Solidity: event ContentUpdated(uint256 indexed tokenId, string prompt, string licenseTerms, uint256 licensePrice)
+func (_LicenZContent *LicenZContentFilterer) FilterContentUpdated(opts *bind.FilterOpts, tokenId []*big.Int) (*LicenZContentContentUpdatedIterator, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LicenZContent.contract.FilterLogs(opts, "ContentUpdated", tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentContentUpdatedIterator{contract: _LicenZContent.contract, event: "ContentUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchContentUpdated is a free log subscription operation binding the contract event 0x646810720d040db259fc72fe01b4d52d25284365fa35cc4817f84a84c719a03e.
+//
+// Solidity: event ContentUpdated(uint256 indexed tokenId, string prompt, string licenseTerms, uint256 licensePrice)
+func (_LicenZContent *LicenZContentFilterer) WatchContentUpdated(opts *bind.WatchOpts, sink chan<- *LicenZContentContentUpdated, tokenId []*big.Int) (event.Subscription, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LicenZContent.contract.WatchLogs(opts, "ContentUpdated", tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZContentContentUpdated)
+				if err := _LicenZContent.contract.UnpackLog(event, "ContentUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContentUpdated is a log parse operation binding the contract event 0x646810720d040db259fc72fe01b4d52d25284365fa35cc4817f84a84c719a03e.
+//
+// Solidity: event ContentUpdated(uint256 indexed tokenId, string prompt, string licenseTerms, uint256 licensePrice)
+func (_LicenZContent *LicenZContentFilterer) ParseContentUpdated(log types.Log) (*LicenZContentContentUpdated, error) {
+	event := new(LicenZContentContentUpdated)
+	if err := _LicenZContent.contract.UnpackLog(event, "ContentUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZContentOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the LicenZContent contract.
+type LicenZContentOwnershipTransferredIterator struct {
+	Event *LicenZContentOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZContentOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZContentOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZContentOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZContentOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZContentOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZContentOwnershipTransferred represents a OwnershipTransferred event raised by the LicenZContent contract.
+type LicenZContentOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LicenZContent *LicenZContentFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*LicenZContentOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LicenZContent.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentOwnershipTransferredIterator{contract: _LicenZContent.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LicenZContent *LicenZContentFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *LicenZContentOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LicenZContent.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZContentOwnershipTransferred)
+				if err := _LicenZContent.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LicenZContent *LicenZContentFilterer) ParseOwnershipTransferred(log types.Log) (*LicenZContentOwnershipTransferred, error) {
+	event := new(LicenZContentOwnershipTransferred)
+	if err := _LicenZContent.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZContentTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the LicenZContent contract.
+type LicenZContentTransferIterator struct {
+	Event *LicenZContentTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZContentTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZContentTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZContentTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZContentTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZContentTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZContentTransfer represents a Transfer event raised by the LicenZContent contract.
+type LicenZContentTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LicenZContent *LicenZContentFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*LicenZContentTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LicenZContent.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentTransferIterator{contract: _LicenZContent.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LicenZContent *LicenZContentFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *LicenZContentTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LicenZContent.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZContentTransfer)
+				if err := _LicenZContent.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LicenZContent *LicenZContentFilterer) ParseTransfer(log types.Log) (*LicenZContentTransfer, error) {
+	event := new(LicenZContentTransfer)
+	if err := _LicenZContent.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
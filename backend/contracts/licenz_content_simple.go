@@ -0,0 +1,1168 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// LicenZContentSimpleContent is an auto generated low-level Go binding around an user-defined struct.
+type LicenZContentSimpleContent struct {
+	Id           *big.Int
+	Creator      common.Address
+	Prompt       string
+	IpfsHash     string
+	Style        string
+	CfgScale     *big.Int
+	Steps        *big.Int
+	Height       *big.Int
+	Width        *big.Int
+	Model        string
+	CreatedAt    *big.Int
+	IsLicensed   bool
+	LicensePrice *big.Int
+	LicenseTerms string
+	Licensee     common.Address
+	LicensedAt   *big.Int
+}
+
+// LicenZContentSimpleMetaData contains all meta data concerning the LicenZContentSimple contract.
+var LicenZContentSimpleMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"prompt\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"createdAt\",\"type\":\"uint256\"}],\"name\":\"ContentCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"licensee\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"licensedAt\",\"type\":\"uint256\"}],\"name\":\"ContentLicensed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"prompt\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"licenseTerms\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"licensePrice\",\"type\":\"uint256\"}],\"name\":\"ContentUpdated\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"contentExists\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"contents\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"prompt\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"style\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"cfgScale\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"steps\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"height\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"width\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"model\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"createdAt\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"isLicensed\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"licensePrice\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"licenseTerms\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"licensee\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"licensedAt\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"prompt\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"style\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"cfgScale\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"steps\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"height\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"width\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"model\",\"type\":\"string\"}],\"name\":\"createContent\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"creatorContent\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getContent\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"prompt\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"style\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"cfgScale\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"steps\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"height\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"width\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"model\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"createdAt\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"isLicensed\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"licensePrice\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"licenseTerms\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"licensee\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"licensedAt\",\"type\":\"uint256\"}],\"internalType\":\"structLicenZContentSimple.Content\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"}],\"name\":\"getContentByIPFS\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"prompt\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"style\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"cfgScale\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"steps\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"height\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"width\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"model\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"createdAt\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"isLicensed\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"licensePrice\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"licenseTerms\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"licensee\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"licensedAt\",\"type\":\"uint256\"}],\"internalType\":\"structLicenZContentSimple.Content\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"getCreatorContent\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"getCreatorContentCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"}],\"name\":\"getTokenByIPFS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTotalContentCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"name\":\"ipfsToToken\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"ipfsHash\",\"type\":\"string\"}],\"name\":\"isIPFSHashUsed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"purchaseLicense\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"terms\",\"type\":\"string\"}],\"name\":\"setLicense\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"newPrompt\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"newTerms\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"newPrice\",\"type\":\"uint256\"}],\"name\":\"updateContent\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// LicenZContentSimpleABI is the input ABI used to generate the binding from.
+// Deprecated: Use LicenZContentSimpleMetaData.ABI instead.
+var LicenZContentSimpleABI = LicenZContentSimpleMetaData.ABI
+
+// LicenZContentSimple is an auto generated Go binding around an Ethereum contract.
+type LicenZContentSimple struct {
+	LicenZContentSimpleCaller     // Read-only binding to the contract
+	LicenZContentSimpleTransactor // Write-only binding to the contract
+	LicenZContentSimpleFilterer   // Log filterer for contract events
+}
+
+// LicenZContentSimpleCaller is an auto generated read-only Go binding around an Ethereum contract.
+type LicenZContentSimpleCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LicenZContentSimpleTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type LicenZContentSimpleTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LicenZContentSimpleFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type LicenZContentSimpleFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LicenZContentSimpleSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type LicenZContentSimpleSession struct {
+	Contract     *LicenZContentSimple // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts        // Call options to use throughout this session
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// LicenZContentSimpleCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type LicenZContentSimpleCallerSession struct {
+	Contract *LicenZContentSimpleCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts              // Call options to use throughout this session
+}
+
+// LicenZContentSimpleTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type LicenZContentSimpleTransactorSession struct {
+	Contract     *LicenZContentSimpleTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// LicenZContentSimpleRaw is an auto generated low-level Go binding around an Ethereum contract.
+type LicenZContentSimpleRaw struct {
+	Contract *LicenZContentSimple // Generic contract binding to access the raw methods on
+}
+
+// LicenZContentSimpleCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type LicenZContentSimpleCallerRaw struct {
+	Contract *LicenZContentSimpleCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// LicenZContentSimpleTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type LicenZContentSimpleTransactorRaw struct {
+	Contract *LicenZContentSimpleTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewLicenZContentSimple creates a new instance of LicenZContentSimple, bound to a specific deployed contract.
+func NewLicenZContentSimple(address common.Address, backend bind.ContractBackend) (*LicenZContentSimple, error) {
+	contract, err := bindLicenZContentSimple(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentSimple{LicenZContentSimpleCaller: LicenZContentSimpleCaller{contract: contract}, LicenZContentSimpleTransactor: LicenZContentSimpleTransactor{contract: contract}, LicenZContentSimpleFilterer: LicenZContentSimpleFilterer{contract: contract}}, nil
+}
+
+// NewLicenZContentSimpleCaller creates a new read-only instance of LicenZContentSimple, bound to a specific deployed contract.
+func NewLicenZContentSimpleCaller(address common.Address, caller bind.ContractCaller) (*LicenZContentSimpleCaller, error) {
+	contract, err := bindLicenZContentSimple(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentSimpleCaller{contract: contract}, nil
+}
+
+// NewLicenZContentSimpleTransactor creates a new write-only instance of LicenZContentSimple, bound to a specific deployed contract.
+func NewLicenZContentSimpleTransactor(address common.Address, transactor bind.ContractTransactor) (*LicenZContentSimpleTransactor, error) {
+	contract, err := bindLicenZContentSimple(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentSimpleTransactor{contract: contract}, nil
+}
+
+// NewLicenZContentSimpleFilterer creates a new log filterer instance of LicenZContentSimple, bound to a specific deployed contract.
+func NewLicenZContentSimpleFilterer(address common.Address, filterer bind.ContractFilterer) (*LicenZContentSimpleFilterer, error) {
+	contract, err := bindLicenZContentSimple(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentSimpleFilterer{contract: contract}, nil
+}
+
+// bindLicenZContentSimple binds a generic wrapper to an already deployed contract.
+func bindLicenZContentSimple(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := LicenZContentSimpleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LicenZContentSimple *LicenZContentSimpleRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LicenZContentSimple.Contract.LicenZContentSimpleCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LicenZContentSimple *LicenZContentSimpleRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LicenZContentSimple.Contract.LicenZContentSimpleTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LicenZContentSimple *LicenZContentSimpleRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LicenZContentSimple.Contract.LicenZContentSimpleTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LicenZContentSimple *LicenZContentSimpleCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LicenZContentSimple.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LicenZContentSimple *LicenZContentSimpleTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LicenZContentSimple.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LicenZContentSimple *LicenZContentSimpleTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LicenZContentSimple.Contract.contract.Transact(opts, method, params...)
+}
+
+// ContentExists is a free data retrieval call binding the contract method 0x724121ae.
+//
+// Solidity: function contentExists(uint256 tokenId) view returns(bool)
+func (_LicenZContentSimple *LicenZContentSimpleCaller) ContentExists(opts *bind.CallOpts, tokenId *big.Int) (bool, error) {
+	var out []interface{}
+	err := _LicenZContentSimple.contract.Call(opts, &out, "contentExists", tokenId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// ContentExists is a free data retrieval call binding the contract method 0x724121ae.
+//
+// Solidity: function contentExists(uint256 tokenId) view returns(bool)
+func (_LicenZContentSimple *LicenZContentSimpleSession) ContentExists(tokenId *big.Int) (bool, error) {
+	return _LicenZContentSimple.Contract.ContentExists(&_LicenZContentSimple.CallOpts, tokenId)
+}
+
+// ContentExists is a free data retrieval call binding the contract method 0x724121ae.
+//
+// Solidity: function contentExists(uint256 tokenId) view returns(bool)
+func (_LicenZContentSimple *LicenZContentSimpleCallerSession) ContentExists(tokenId *big.Int) (bool, error) {
+	return _LicenZContentSimple.Contract.ContentExists(&_LicenZContentSimple.CallOpts, tokenId)
+}
+
+// Contents is a free data retrieval call binding the contract method 0xb5ecf912.
+//
+// Solidity: function contents(uint256 ) view returns(uint256 id, address creator, string prompt, string ipfsHash, string style, uint256 cfgScale, uint256 steps, uint256 height, uint256 width, string model, uint256 createdAt, bool isLicensed, uint256 licensePrice, string licenseTerms, address licensee, uint256 licensedAt)
+func (_LicenZContentSimple *LicenZContentSimpleCaller) Contents(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	Id           *big.Int
+	Creator      common.Address
+	Prompt       string
+	IpfsHash     string
+	Style        string
+	CfgScale     *big.Int
+	Steps        *big.Int
+	Height       *big.Int
+	Width        *big.Int
+	Model        string
+	CreatedAt    *big.Int
+	IsLicensed   bool
+	LicensePrice *big.Int
+	LicenseTerms string
+	Licensee     common.Address
+	LicensedAt   *big.Int
+}, error) {
+	var out []interface{}
+	err := _LicenZContentSimple.contract.Call(opts, &out, "contents", arg0)
+
+	outstruct := new(struct {
+		Id           *big.Int
+		Creator      common.Address
+		Prompt       string
+		IpfsHash     string
+		Style        string
+		CfgScale     *big.Int
+		Steps        *big.Int
+		Height       *big.Int
+		Width        *big.Int
+		Model        string
+		CreatedAt    *big.Int
+		IsLicensed   bool
+		LicensePrice *big.Int
+		LicenseTerms string
+		Licensee     common.Address
+		LicensedAt   *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Id = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Creator = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.Prompt = *abi.ConvertType(out[2], new(string)).(*string)
+	outstruct.IpfsHash = *abi.ConvertType(out[3], new(string)).(*string)
+	outstruct.Style = *abi.ConvertType(out[4], new(string)).(*string)
+	outstruct.CfgScale = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+	outstruct.Steps = *abi.ConvertType(out[6], new(*big.Int)).(**big.Int)
+	outstruct.Height = *abi.ConvertType(out[7], new(*big.Int)).(**big.Int)
+	outstruct.Width = *abi.ConvertType(out[8], new(*big.Int)).(**big.Int)
+	outstruct.Model = *abi.ConvertType(out[9], new(string)).(*string)
+	outstruct.CreatedAt = *abi.ConvertType(out[10], new(*big.Int)).(**big.Int)
+	outstruct.IsLicensed = *abi.ConvertType(out[11], new(bool)).(*bool)
+	outstruct.LicensePrice = *abi.ConvertType(out[12], new(*big.Int)).(**big.Int)
+	outstruct.LicenseTerms = *abi.ConvertType(out[13], new(string)).(*string)
+	outstruct.Licensee = *abi.ConvertType(out[14], new(common.Address)).(*common.Address)
+	outstruct.LicensedAt = *abi.ConvertType(out[15], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Contents is a free data retrieval call binding the contract method 0xb5ecf912.
+//
+// Solidity: function contents(uint256 ) view returns(uint256 id, address creator, string prompt, string ipfsHash, string style, uint256 cfgScale, uint256 steps, uint256 height, uint256 width, string model, uint256 createdAt, bool isLicensed, uint256 licensePrice, string licenseTerms, address licensee, uint256 licensedAt)
+func (_LicenZContentSimple *LicenZContentSimpleSession) Contents(arg0 *big.Int) (struct {
+	Id           *big.Int
+	Creator      common.Address
+	Prompt       string
+	IpfsHash     string
+	Style        string
+	CfgScale     *big.Int
+	Steps        *big.Int
+	Height       *big.Int
+	Width        *big.Int
+	Model        string
+	CreatedAt    *big.Int
+	IsLicensed   bool
+	LicensePrice *big.Int
+	LicenseTerms string
+	Licensee     common.Address
+	LicensedAt   *big.Int
+}, error) {
+	return _LicenZContentSimple.Contract.Contents(&_LicenZContentSimple.CallOpts, arg0)
+}
+
+// Contents is a free data retrieval call binding the contract method 0xb5ecf912.
+//
+// Solidity: function contents(uint256 ) view returns(uint256 id, address creator, string prompt, string ipfsHash, string style, uint256 cfgScale, uint256 steps, uint256 height, uint256 width, string model, uint256 createdAt, bool isLicensed, uint256 licensePrice, string licenseTerms, address licensee, uint256 licensedAt)
+func (_LicenZContentSimple *LicenZContentSimpleCallerSession) Contents(arg0 *big.Int) (struct {
+	Id           *big.Int
+	Creator      common.Address
+	Prompt       string
+	IpfsHash     string
+	Style        string
+	CfgScale     *big.Int
+	Steps        *big.Int
+	Height       *big.Int
+	Width        *big.Int
+	Model        string
+	CreatedAt    *big.Int
+	IsLicensed   bool
+	LicensePrice *big.Int
+	LicenseTerms string
+	Licensee     common.Address
+	LicensedAt   *big.Int
+}, error) {
+	return _LicenZContentSimple.Contract.Contents(&_LicenZContentSimple.CallOpts, arg0)
+}
+
+// CreatorContent is a free data retrieval call binding the contract method 0x6a480eb8.
+//
+// Solidity: function creatorContent(address , uint256 ) view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleCaller) CreatorContent(opts *bind.CallOpts, arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZContentSimple.contract.Call(opts, &out, "creatorContent", arg0, arg1)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CreatorContent is a free data retrieval call binding the contract method 0x6a480eb8.
+//
+// Solidity: function creatorContent(address , uint256 ) view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleSession) CreatorContent(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _LicenZContentSimple.Contract.CreatorContent(&_LicenZContentSimple.CallOpts, arg0, arg1)
+}
+
+// CreatorContent is a free data retrieval call binding the contract method 0x6a480eb8.
+//
+// Solidity: function creatorContent(address , uint256 ) view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleCallerSession) CreatorContent(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _LicenZContentSimple.Contract.CreatorContent(&_LicenZContentSimple.CallOpts, arg0, arg1)
+}
+
+// GetContent is a free data retrieval call binding the contract method 0x0b7ad54c.
+//
+// Solidity: function getContent(uint256 tokenId) view returns((uint256,address,string,string,string,uint256,uint256,uint256,uint256,string,uint256,bool,uint256,string,address,uint256))
+func (_LicenZContentSimple *LicenZContentSimpleCaller) GetContent(opts *bind.CallOpts, tokenId *big.Int) (LicenZContentSimpleContent, error) {
+	var out []interface{}
+	err := _LicenZContentSimple.contract.Call(opts, &out, "getContent", tokenId)
+
+	if err != nil {
+		return *new(LicenZContentSimpleContent), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(LicenZContentSimpleContent)).(*LicenZContentSimpleContent)
+
+	return out0, err
+
+}
+
+// GetContent is a free data retrieval call binding the contract method 0x0b7ad54c.
+//
+// Solidity: function getContent(uint256 tokenId) view returns((uint256,address,string,string,string,uint256,uint256,uint256,uint256,string,uint256,bool,uint256,string,address,uint256))
+func (_LicenZContentSimple *LicenZContentSimpleSession) GetContent(tokenId *big.Int) (LicenZContentSimpleContent, error) {
+	return _LicenZContentSimple.Contract.GetContent(&_LicenZContentSimple.CallOpts, tokenId)
+}
+
+// GetContent is a free data retrieval call binding the contract method 0x0b7ad54c.
+//
+// Solidity: function getContent(uint256 tokenId) view returns((uint256,address,string,string,string,uint256,uint256,uint256,uint256,string,uint256,bool,uint256,string,address,uint256))
+func (_LicenZContentSimple *LicenZContentSimpleCallerSession) GetContent(tokenId *big.Int) (LicenZContentSimpleContent, error) {
+	return _LicenZContentSimple.Contract.GetContent(&_LicenZContentSimple.CallOpts, tokenId)
+}
+
+// GetContentByIPFS is a free data retrieval call binding the contract method 0x557ee27a.
+//
+// Solidity: function getContentByIPFS(string ipfsHash) view returns((uint256,address,string,string,string,uint256,uint256,uint256,uint256,string,uint256,bool,uint256,string,address,uint256))
+func (_LicenZContentSimple *LicenZContentSimpleCaller) GetContentByIPFS(opts *bind.CallOpts, ipfsHash string) (LicenZContentSimpleContent, error) {
+	var out []interface{}
+	err := _LicenZContentSimple.contract.Call(opts, &out, "getContentByIPFS", ipfsHash)
+
+	if err != nil {
+		return *new(LicenZContentSimpleContent), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(LicenZContentSimpleContent)).(*LicenZContentSimpleContent)
+
+	return out0, err
+
+}
+
+// GetContentByIPFS is a free data retrieval call binding the contract method 0x557ee27a.
+//
+// Solidity: function getContentByIPFS(string ipfsHash) view returns((uint256,address,string,string,string,uint256,uint256,uint256,uint256,string,uint256,bool,uint256,string,address,uint256))
+func (_LicenZContentSimple *LicenZContentSimpleSession) GetContentByIPFS(ipfsHash string) (LicenZContentSimpleContent, error) {
+	return _LicenZContentSimple.Contract.GetContentByIPFS(&_LicenZContentSimple.CallOpts, ipfsHash)
+}
+
+// GetContentByIPFS is a free data retrieval call binding the contract method 0x557ee27a.
+//
+// Solidity: function getContentByIPFS(string ipfsHash) view returns((uint256,address,string,string,string,uint256,uint256,uint256,uint256,string,uint256,bool,uint256,string,address,uint256))
+func (_LicenZContentSimple *LicenZContentSimpleCallerSession) GetContentByIPFS(ipfsHash string) (LicenZContentSimpleContent, error) {
+	return _LicenZContentSimple.Contract.GetContentByIPFS(&_LicenZContentSimple.CallOpts, ipfsHash)
+}
+
+// GetCreatorContent is a free data retrieval call binding the contract method 0x011c6756.
+//
+// Solidity: function getCreatorContent(address creator) view returns(uint256[])
+func (_LicenZContentSimple *LicenZContentSimpleCaller) GetCreatorContent(opts *bind.CallOpts, creator common.Address) ([]*big.Int, error) {
+	var out []interface{}
+	err := _LicenZContentSimple.contract.Call(opts, &out, "getCreatorContent", creator)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetCreatorContent is a free data retrieval call binding the contract method 0x011c6756.
+//
+// Solidity: function getCreatorContent(address creator) view returns(uint256[])
+func (_LicenZContentSimple *LicenZContentSimpleSession) GetCreatorContent(creator common.Address) ([]*big.Int, error) {
+	return _LicenZContentSimple.Contract.GetCreatorContent(&_LicenZContentSimple.CallOpts, creator)
+}
+
+// GetCreatorContent is a free data retrieval call binding the contract method 0x011c6756.
+//
+// Solidity: function getCreatorContent(address creator) view returns(uint256[])
+func (_LicenZContentSimple *LicenZContentSimpleCallerSession) GetCreatorContent(creator common.Address) ([]*big.Int, error) {
+	return _LicenZContentSimple.Contract.GetCreatorContent(&_LicenZContentSimple.CallOpts, creator)
+}
+
+// GetCreatorContentCount is a free data retrieval call binding the contract method 0xd6e429e5.
+//
+// Solidity: function getCreatorContentCount(address creator) view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleCaller) GetCreatorContentCount(opts *bind.CallOpts, creator common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZContentSimple.contract.Call(opts, &out, "getCreatorContentCount", creator)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCreatorContentCount is a free data retrieval call binding the contract method 0xd6e429e5.
+//
+// Solidity: function getCreatorContentCount(address creator) view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleSession) GetCreatorContentCount(creator common.Address) (*big.Int, error) {
+	return _LicenZContentSimple.Contract.GetCreatorContentCount(&_LicenZContentSimple.CallOpts, creator)
+}
+
+// GetCreatorContentCount is a free data retrieval call binding the contract method 0xd6e429e5.
+//
+// Solidity: function getCreatorContentCount(address creator) view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleCallerSession) GetCreatorContentCount(creator common.Address) (*big.Int, error) {
+	return _LicenZContentSimple.Contract.GetCreatorContentCount(&_LicenZContentSimple.CallOpts, creator)
+}
+
+// GetTokenByIPFS is a free data retrieval call binding the contract method 0x79295d37.
+//
+// Solidity: function getTokenByIPFS(string ipfsHash) view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleCaller) GetTokenByIPFS(opts *bind.CallOpts, ipfsHash string) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZContentSimple.contract.Call(opts, &out, "getTokenByIPFS", ipfsHash)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTokenByIPFS is a free data retrieval call binding the contract method 0x79295d37.
+//
+// Solidity: function getTokenByIPFS(string ipfsHash) view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleSession) GetTokenByIPFS(ipfsHash string) (*big.Int, error) {
+	return _LicenZContentSimple.Contract.GetTokenByIPFS(&_LicenZContentSimple.CallOpts, ipfsHash)
+}
+
+// GetTokenByIPFS is a free data retrieval call binding the contract method 0x79295d37.
+//
+// Solidity: function getTokenByIPFS(string ipfsHash) view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleCallerSession) GetTokenByIPFS(ipfsHash string) (*big.Int, error) {
+	return _LicenZContentSimple.Contract.GetTokenByIPFS(&_LicenZContentSimple.CallOpts, ipfsHash)
+}
+
+// GetTotalContentCount is a free data retrieval call binding the contract method 0x31d95dd0.
+//
+// Solidity: function getTotalContentCount() view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleCaller) GetTotalContentCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZContentSimple.contract.Call(opts, &out, "getTotalContentCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTotalContentCount is a free data retrieval call binding the contract method 0x31d95dd0.
+//
+// Solidity: function getTotalContentCount() view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleSession) GetTotalContentCount() (*big.Int, error) {
+	return _LicenZContentSimple.Contract.GetTotalContentCount(&_LicenZContentSimple.CallOpts)
+}
+
+// GetTotalContentCount is a free data retrieval call binding the contract method 0x31d95dd0.
+//
+// Solidity: function getTotalContentCount() view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleCallerSession) GetTotalContentCount() (*big.Int, error) {
+	return _LicenZContentSimple.Contract.GetTotalContentCount(&_LicenZContentSimple.CallOpts)
+}
+
+// IpfsToToken is a free data retrieval call binding the contract method 0xd4188b0a.
+//
+// Solidity: function ipfsToToken(string ) view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleCaller) IpfsToToken(opts *bind.CallOpts, arg0 string) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZContentSimple.contract.Call(opts, &out, "ipfsToToken", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// IpfsToToken is a free data retrieval call binding the contract method 0xd4188b0a.
+//
+// Solidity: function ipfsToToken(string ) view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleSession) IpfsToToken(arg0 string) (*big.Int, error) {
+	return _LicenZContentSimple.Contract.IpfsToToken(&_LicenZContentSimple.CallOpts, arg0)
+}
+
+// IpfsToToken is a free data retrieval call binding the contract method 0xd4188b0a.
+//
+// Solidity: function ipfsToToken(string ) view returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleCallerSession) IpfsToToken(arg0 string) (*big.Int, error) {
+	return _LicenZContentSimple.Contract.IpfsToToken(&_LicenZContentSimple.CallOpts, arg0)
+}
+
+// IsIPFSHashUsed is a free data retrieval call binding the contract method 0xe968e83d.
+//
+// Solidity: function isIPFSHashUsed(string ipfsHash) view returns(bool)
+func (_LicenZContentSimple *LicenZContentSimpleCaller) IsIPFSHashUsed(opts *bind.CallOpts, ipfsHash string) (bool, error) {
+	var out []interface{}
+	err := _LicenZContentSimple.contract.Call(opts, &out, "isIPFSHashUsed", ipfsHash)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsIPFSHashUsed is a free data retrieval call binding the contract method 0xe968e83d.
+//
+// Solidity: function isIPFSHashUsed(string ipfsHash) view returns(bool)
+func (_LicenZContentSimple *LicenZContentSimpleSession) IsIPFSHashUsed(ipfsHash string) (bool, error) {
+	return _LicenZContentSimple.Contract.IsIPFSHashUsed(&_LicenZContentSimple.CallOpts, ipfsHash)
+}
+
+// IsIPFSHashUsed is a free data retrieval call binding the contract method 0xe968e83d.
+//
+// Solidity: function isIPFSHashUsed(string ipfsHash) view returns(bool)
+func (_LicenZContentSimple *LicenZContentSimpleCallerSession) IsIPFSHashUsed(ipfsHash string) (bool, error) {
+	return _LicenZContentSimple.Contract.IsIPFSHashUsed(&_LicenZContentSimple.CallOpts, ipfsHash)
+}
+
+// CreateContent is a paid mutator transaction binding the contract method 0x910ab01a.
+//
+// Solidity: function createContent(string prompt, string ipfsHash, string style, uint256 cfgScale, uint256 steps, uint256 height, uint256 width, string model) returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleTransactor) CreateContent(opts *bind.TransactOpts, prompt string, ipfsHash string, style string, cfgScale *big.Int, steps *big.Int, height *big.Int, width *big.Int, model string) (*types.Transaction, error) {
+	return _LicenZContentSimple.contract.Transact(opts, "createContent", prompt, ipfsHash, style, cfgScale, steps, height, width, model)
+}
+
+// CreateContent is a paid mutator transaction binding the contract method 0x910ab01a.
+//
+// Solidity: function createContent(string prompt, string ipfsHash, string style, uint256 cfgScale, uint256 steps, uint256 height, uint256 width, string model) returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleSession) CreateContent(prompt string, ipfsHash string, style string, cfgScale *big.Int, steps *big.Int, height *big.Int, width *big.Int, model string) (*types.Transaction, error) {
+	return _LicenZContentSimple.Contract.CreateContent(&_LicenZContentSimple.TransactOpts, prompt, ipfsHash, style, cfgScale, steps, height, width, model)
+}
+
+// CreateContent is a paid mutator transaction binding the contract method 0x910ab01a.
+//
+// Solidity: function createContent(string prompt, string ipfsHash, string style, uint256 cfgScale, uint256 steps, uint256 height, uint256 width, string model) returns(uint256)
+func (_LicenZContentSimple *LicenZContentSimpleTransactorSession) CreateContent(prompt string, ipfsHash string, style string, cfgScale *big.Int, steps *big.Int, height *big.Int, width *big.Int, model string) (*types.Transaction, error) {
+	return _LicenZContentSimple.Contract.CreateContent(&_LicenZContentSimple.TransactOpts, prompt, ipfsHash, style, cfgScale, steps, height, width, model)
+}
+
+// PurchaseLicense is a paid mutator transaction binding the contract method 0xc8a028a8.
+//
+// Solidity: function purchaseLicense(uint256 tokenId) payable returns()
+func (_LicenZContentSimple *LicenZContentSimpleTransactor) PurchaseLicense(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContentSimple.contract.Transact(opts, "purchaseLicense", tokenId)
+}
+
+// PurchaseLicense is a paid mutator transaction binding the contract method 0xc8a028a8.
+//
+// Solidity: function purchaseLicense(uint256 tokenId) payable returns()
+func (_LicenZContentSimple *LicenZContentSimpleSession) PurchaseLicense(tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContentSimple.Contract.PurchaseLicense(&_LicenZContentSimple.TransactOpts, tokenId)
+}
+
+// PurchaseLicense is a paid mutator transaction binding the contract method 0xc8a028a8.
+//
+// Solidity: function purchaseLicense(uint256 tokenId) payable returns()
+func (_LicenZContentSimple *LicenZContentSimpleTransactorSession) PurchaseLicense(tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZContentSimple.Contract.PurchaseLicense(&_LicenZContentSimple.TransactOpts, tokenId)
+}
+
+// SetLicense is a paid mutator transaction binding the contract method 0x1098ab17.
+//
+// Solidity: function setLicense(uint256 tokenId, uint256 price, string terms) returns()
+func (_LicenZContentSimple *LicenZContentSimpleTransactor) SetLicense(opts *bind.TransactOpts, tokenId *big.Int, price *big.Int, terms string) (*types.Transaction, error) {
+	return _LicenZContentSimple.contract.Transact(opts, "setLicense", tokenId, price, terms)
+}
+
+// SetLicense is a paid mutator transaction binding the contract method 0x1098ab17.
+//
+// Solidity: function setLicense(uint256 tokenId, uint256 price, string terms) returns()
+func (_LicenZContentSimple *LicenZContentSimpleSession) SetLicense(tokenId *big.Int, price *big.Int, terms string) (*types.Transaction, error) {
+	return _LicenZContentSimple.Contract.SetLicense(&_LicenZContentSimple.TransactOpts, tokenId, price, terms)
+}
+
+// SetLicense is a paid mutator transaction binding the contract method 0x1098ab17.
+//
+// Solidity: function setLicense(uint256 tokenId, uint256 price, string terms) returns()
+func (_LicenZContentSimple *LicenZContentSimpleTransactorSession) SetLicense(tokenId *big.Int, price *big.Int, terms string) (*types.Transaction, error) {
+	return _LicenZContentSimple.Contract.SetLicense(&_LicenZContentSimple.TransactOpts, tokenId, price, terms)
+}
+
+// UpdateContent is a paid mutator transaction binding the contract method 0x58701682.
+//
+// Solidity: function updateContent(uint256 tokenId, string newPrompt, string newTerms, uint256 newPrice) returns()
+func (_LicenZContentSimple *LicenZContentSimpleTransactor) UpdateContent(opts *bind.TransactOpts, tokenId *big.Int, newPrompt string, newTerms string, newPrice *big.Int) (*types.Transaction, error) {
+	return _LicenZContentSimple.contract.Transact(opts, "updateContent", tokenId, newPrompt, newTerms, newPrice)
+}
+
+// UpdateContent is a paid mutator transaction binding the contract method 0x58701682.
+//
+// Solidity: function updateContent(uint256 tokenId, string newPrompt, string newTerms, uint256 newPrice) returns()
+func (_LicenZContentSimple *LicenZContentSimpleSession) UpdateContent(tokenId *big.Int, newPrompt string, newTerms string, newPrice *big.Int) (*types.Transaction, error) {
+	return _LicenZContentSimple.Contract.UpdateContent(&_LicenZContentSimple.TransactOpts, tokenId, newPrompt, newTerms, newPrice)
+}
+
+// UpdateContent is a paid mutator transaction binding the contract method 0x58701682.
+//
+// Solidity: function updateContent(uint256 tokenId, string newPrompt, string newTerms, uint256 newPrice) returns()
+func (_LicenZContentSimple *LicenZContentSimpleTransactorSession) UpdateContent(tokenId *big.Int, newPrompt string, newTerms string, newPrice *big.Int) (*types.Transaction, error) {
+	return _LicenZContentSimple.Contract.UpdateContent(&_LicenZContentSimple.TransactOpts, tokenId, newPrompt, newTerms, newPrice)
+}
+
+// LicenZContentSimpleContentCreatedIterator is returned from FilterContentCreated and is used to iterate over the raw logs and unpacked data for ContentCreated events raised by the LicenZContentSimple contract.
+type LicenZContentSimpleContentCreatedIterator struct {
+	Event *LicenZContentSimpleContentCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZContentSimpleContentCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZContentSimpleContentCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZContentSimpleContentCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZContentSimpleContentCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZContentSimpleContentCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZContentSimpleContentCreated represents a ContentCreated event raised by the LicenZContentSimple contract.
+type LicenZContentSimpleContentCreated struct {
+	TokenId   *big.Int
+	Creator   common.Address
+	Prompt    string
+	IpfsHash  string
+	CreatedAt *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterContentCreated is a free log retrieval operation binding the contract event 0x30e02d5244771b6f1d783570253a777f1e5461226589818b7f5de028e0cb83cf.
+//
+// Solidity: event ContentCreated(uint256 indexed tokenId, address indexed creator, string prompt, string ipfsHash, uint256 createdAt)
+func (_LicenZContentSimple *LicenZContentSimpleFilterer) FilterContentCreated(opts *bind.FilterOpts, tokenId []*big.Int, creator []common.Address) (*LicenZContentSimpleContentCreatedIterator, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _LicenZContentSimple.contract.FilterLogs(opts, "ContentCreated", tokenIdRule, creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentSimpleContentCreatedIterator{contract: _LicenZContentSimple.contract, event: "ContentCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchContentCreated is a free log subscription operation binding the contract event 0x30e02d5244771b6f1d783570253a777f1e5461226589818b7f5de028e0cb83cf.
+//
+// Solidity: event ContentCreated(uint256 indexed tokenId, address indexed creator, string prompt, string ipfsHash, uint256 createdAt)
+func (_LicenZContentSimple *LicenZContentSimpleFilterer) WatchContentCreated(opts *bind.WatchOpts, sink chan<- *LicenZContentSimpleContentCreated, tokenId []*big.Int, creator []common.Address) (event.Subscription, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _LicenZContentSimple.contract.WatchLogs(opts, "ContentCreated", tokenIdRule, creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZContentSimpleContentCreated)
+				if err := _LicenZContentSimple.contract.UnpackLog(event, "ContentCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContentCreated is a log parse operation binding the contract event 0x30e02d5244771b6f1d783570253a777f1e5461226589818b7f5de028e0cb83cf.
+//
+// Solidity: event ContentCreated(uint256 indexed tokenId, address indexed creator, string prompt, string ipfsHash, uint256 createdAt)
+func (_LicenZContentSimple *LicenZContentSimpleFilterer) ParseContentCreated(log types.Log) (*LicenZContentSimpleContentCreated, error) {
+	event := new(LicenZContentSimpleContentCreated)
+	if err := _LicenZContentSimple.contract.UnpackLog(event, "ContentCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZContentSimpleContentLicensedIterator is returned from FilterContentLicensed and is used to iterate over the raw logs and unpacked data for ContentLicensed events raised by the LicenZContentSimple contract.
+type LicenZContentSimpleContentLicensedIterator struct {
+	Event *LicenZContentSimpleContentLicensed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZContentSimpleContentLicensedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZContentSimpleContentLicensed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZContentSimpleContentLicensed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZContentSimpleContentLicensedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZContentSimpleContentLicensedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZContentSimpleContentLicensed represents a ContentLicensed event raised by the LicenZContentSimple contract.
+type LicenZContentSimpleContentLicensed struct {
+	TokenId    *big.Int
+	Licensee   common.Address
+	Price      *big.Int
+	LicensedAt *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterContentLicensed is a free log retrieval operation binding the contract event 0x31fe1db016b6093ec812da5d679f33de6082b3a13915b5e2b5c41a8f3a81ba17.
+//
+// Solidity: event ContentLicensed(uint256 indexed tokenId, address indexed licensee, uint256 price, uint256 licensedAt)
+func (_LicenZContentSimple *LicenZContentSimpleFilterer) FilterContentLicensed(opts *bind.FilterOpts, tokenId []*big.Int, licensee []common.Address) (*LicenZContentSimpleContentLicensedIterator, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+	var licenseeRule []interface{}
+	for _, licenseeItem := range licensee {
+		licenseeRule = append(licenseeRule, licenseeItem)
+	}
+
+	logs, sub, err := _LicenZContentSimple.contract.FilterLogs(opts, "ContentLicensed", tokenIdRule, licenseeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentSimpleContentLicensedIterator{contract: _LicenZContentSimple.contract, event: "ContentLicensed", logs: logs, sub: sub}, nil
+}
+
+// WatchContentLicensed is a free log subscription operation binding the contract event 0x31fe1db016b6093ec812da5d679f33de6082b3a13915b5e2b5c41a8f3a81ba17.
+//
+// Solidity: event ContentLicensed(uint256 indexed tokenId, address indexed licensee, uint256 price, uint256 licensedAt)
+func (_LicenZContentSimple *LicenZContentSimpleFilterer) WatchContentLicensed(opts *bind.WatchOpts, sink chan<- *LicenZContentSimpleContentLicensed, tokenId []*big.Int, licensee []common.Address) (event.Subscription, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+	var licenseeRule []interface{}
+	for _, licenseeItem := range licensee {
+		licenseeRule = append(licenseeRule, licenseeItem)
+	}
+
+	logs, sub, err := _LicenZContentSimple.contract.WatchLogs(opts, "ContentLicensed", tokenIdRule, licenseeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZContentSimpleContentLicensed)
+				if err := _LicenZContentSimple.contract.UnpackLog(event, "ContentLicensed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContentLicensed is a log parse operation binding the contract event 0x31fe1db016b6093ec812da5d679f33de6082b3a13915b5e2b5c41a8f3a81ba17.
+//
+// Solidity: event ContentLicensed(uint256 indexed tokenId, address indexed licensee, uint256 price, uint256 licensedAt)
+func (_LicenZContentSimple *LicenZContentSimpleFilterer) ParseContentLicensed(log types.Log) (*LicenZContentSimpleContentLicensed, error) {
+	event := new(LicenZContentSimpleContentLicensed)
+	if err := _LicenZContentSimple.contract.UnpackLog(event, "ContentLicensed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZContentSimpleContentUpdatedIterator is returned from FilterContentUpdated and is used to iterate over the raw logs and unpacked data for ContentUpdated events raised by the LicenZContentSimple contract.
+type LicenZContentSimpleContentUpdatedIterator struct {
+	Event *LicenZContentSimpleContentUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZContentSimpleContentUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZContentSimpleContentUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZContentSimpleContentUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZContentSimpleContentUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZContentSimpleContentUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZContentSimpleContentUpdated represents a ContentUpdated event raised by the LicenZContentSimple contract.
+type LicenZContentSimpleContentUpdated struct {
+	TokenId      *big.Int
+	Prompt       string
+	LicenseTerms string
+	LicensePrice *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterContentUpdated is a free log retrieval operation binding the contract event 0x646810720d040db259fc72fe01b4d52d25284365fa35cc4817f84a84c719a03e.
+//
+// Solidity: event ContentUpdated(uint256 indexed tokenId, string prompt, string licenseTerms, uint256 licensePrice)
+func (_LicenZContentSimple *LicenZContentSimpleFilterer) FilterContentUpdated(opts *bind.FilterOpts, tokenId []*big.Int) (*LicenZContentSimpleContentUpdatedIterator, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LicenZContentSimple.contract.FilterLogs(opts, "ContentUpdated", tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZContentSimpleContentUpdatedIterator{contract: _LicenZContentSimple.contract, event: "ContentUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchContentUpdated is a free log subscription operation binding the contract event 0x646810720d040db259fc72fe01b4d52d25284365fa35cc4817f84a84c719a03e.
+//
+// Solidity: event ContentUpdated(uint256 indexed tokenId, string prompt, string licenseTerms, uint256 licensePrice)
+func (_LicenZContentSimple *LicenZContentSimpleFilterer) WatchContentUpdated(opts *bind.WatchOpts, sink chan<- *LicenZContentSimpleContentUpdated, tokenId []*big.Int) (event.Subscription, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LicenZContentSimple.contract.WatchLogs(opts, "ContentUpdated", tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZContentSimpleContentUpdated)
+				if err := _LicenZContentSimple.contract.UnpackLog(event, "ContentUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContentUpdated is a log parse operation binding the contract event 0x646810720d040db259fc72fe01b4d52d25284365fa35cc4817f84a84c719a03e.
+//
+// Solidity: event ContentUpdated(uint256 indexed tokenId, string prompt, string licenseTerms, uint256 licensePrice)
+func (_LicenZContentSimple *LicenZContentSimpleFilterer) ParseContentUpdated(log types.Log) (*LicenZContentSimpleContentUpdated, error) {
+	event := new(LicenZContentSimpleContentUpdated)
+	if err := _LicenZContentSimple.contract.UnpackLog(event, "ContentUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,1562 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// LicenZLicenseLicense is an auto generated low-level Go binding around an user-defined struct.
+type LicenZLicenseLicense struct {
+	LicenseId   *big.Int
+	ContentHash [32]byte
+	Price       *big.Int
+	Terms       string
+	Creator     common.Address
+	IsActive    bool
+	CreatedAt   *big.Int
+	PurchasedAt *big.Int
+	Purchaser   common.Address
+	IsPurchased bool
+}
+
+// LicenZLicenseMetaData contains all meta data concerning the LicenZLicense contract.
+var LicenZLicenseMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"licenseId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"contentHash\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"terms\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"LicenseCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"licenseId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"LicenseDeactivated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"licenseId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"purchaser\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"LicensePurchased\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"oldFee\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newFee\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"PlatformFeeUpdated\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"contentLicenses\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"contentHash\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"terms\",\"type\":\"string\"}],\"name\":\"createLicense\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"creatorLicenses\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"licenseId\",\"type\":\"uint256\"}],\"name\":\"deactivateLicense\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getContractBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"licenseId\",\"type\":\"uint256\"}],\"name\":\"getLicense\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"licenseId\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"contentHash\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"terms\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"isActive\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"createdAt\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"purchasedAt\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"purchaser\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"isPurchased\",\"type\":\"bool\"}],\"internalType\":\"structLicenZLicense.License\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"getLicensesByCreator\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"purchaser\",\"type\":\"address\"}],\"name\":\"getLicensesByPurchaser\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"contentHash\",\"type\":\"bytes32\"}],\"name\":\"getLicensesForContent\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"licenseId\",\"type\":\"uint256\"}],\"name\":\"isLicenseValid\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"licenses\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"licenseId\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"contentHash\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"terms\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"isActive\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"createdAt\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"purchasedAt\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"purchaser\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"isPurchased\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"platformFeePercentage\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"licenseId\",\"type\":\"uint256\"}],\"name\":\"purchaseLicense\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"purchaserLicenses\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalLicenses\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newFeePercentage\",\"type\":\"uint256\"}],\"name\":\"updatePlatformFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawPlatformFees\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// LicenZLicenseABI is the input ABI used to generate the binding from.
+// Deprecated: Use LicenZLicenseMetaData.ABI instead.
+var LicenZLicenseABI = LicenZLicenseMetaData.ABI
+
+// LicenZLicense is an auto generated Go binding around an Ethereum contract.
+type LicenZLicense struct {
+	LicenZLicenseCaller     // Read-only binding to the contract
+	LicenZLicenseTransactor // Write-only binding to the contract
+	LicenZLicenseFilterer   // Log filterer for contract events
+}
+
+// LicenZLicenseCaller is an auto generated read-only Go binding around an Ethereum contract.
+type LicenZLicenseCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LicenZLicenseTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type LicenZLicenseTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LicenZLicenseFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type LicenZLicenseFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LicenZLicenseSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type LicenZLicenseSession struct {
+	Contract     *LicenZLicense    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// LicenZLicenseCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type LicenZLicenseCallerSession struct {
+	Contract *LicenZLicenseCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// LicenZLicenseTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type LicenZLicenseTransactorSession struct {
+	Contract     *LicenZLicenseTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// LicenZLicenseRaw is an auto generated low-level Go binding around an Ethereum contract.
+type LicenZLicenseRaw struct {
+	Contract *LicenZLicense // Generic contract binding to access the raw methods on
+}
+
+// LicenZLicenseCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type LicenZLicenseCallerRaw struct {
+	Contract *LicenZLicenseCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// LicenZLicenseTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type LicenZLicenseTransactorRaw struct {
+	Contract *LicenZLicenseTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewLicenZLicense creates a new instance of LicenZLicense, bound to a specific deployed contract.
+func NewLicenZLicense(address common.Address, backend bind.ContractBackend) (*LicenZLicense, error) {
+	contract, err := bindLicenZLicense(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZLicense{LicenZLicenseCaller: LicenZLicenseCaller{contract: contract}, LicenZLicenseTransactor: LicenZLicenseTransactor{contract: contract}, LicenZLicenseFilterer: LicenZLicenseFilterer{contract: contract}}, nil
+}
+
+// NewLicenZLicenseCaller creates a new read-only instance of LicenZLicense, bound to a specific deployed contract.
+func NewLicenZLicenseCaller(address common.Address, caller bind.ContractCaller) (*LicenZLicenseCaller, error) {
+	contract, err := bindLicenZLicense(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZLicenseCaller{contract: contract}, nil
+}
+
+// NewLicenZLicenseTransactor creates a new write-only instance of LicenZLicense, bound to a specific deployed contract.
+func NewLicenZLicenseTransactor(address common.Address, transactor bind.ContractTransactor) (*LicenZLicenseTransactor, error) {
+	contract, err := bindLicenZLicense(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZLicenseTransactor{contract: contract}, nil
+}
+
+// NewLicenZLicenseFilterer creates a new log filterer instance of LicenZLicense, bound to a specific deployed contract.
+func NewLicenZLicenseFilterer(address common.Address, filterer bind.ContractFilterer) (*LicenZLicenseFilterer, error) {
+	contract, err := bindLicenZLicense(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZLicenseFilterer{contract: contract}, nil
+}
+
+// bindLicenZLicense binds a generic wrapper to an already deployed contract.
+func bindLicenZLicense(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := LicenZLicenseMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LicenZLicense *LicenZLicenseRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LicenZLicense.Contract.LicenZLicenseCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LicenZLicense *LicenZLicenseRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LicenZLicense.Contract.LicenZLicenseTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LicenZLicense *LicenZLicenseRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LicenZLicense.Contract.LicenZLicenseTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LicenZLicense *LicenZLicenseCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LicenZLicense.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LicenZLicense *LicenZLicenseTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LicenZLicense.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LicenZLicense *LicenZLicenseTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LicenZLicense.Contract.contract.Transact(opts, method, params...)
+}
+
+// ContentLicenses is a free data retrieval call binding the contract method 0x6c6f99a3.
+//
+// Solidity: function contentLicenses(bytes32 , uint256 ) view returns(uint256)
+func (_LicenZLicense *LicenZLicenseCaller) ContentLicenses(opts *bind.CallOpts, arg0 [32]byte, arg1 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZLicense.contract.Call(opts, &out, "contentLicenses", arg0, arg1)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ContentLicenses is a free data retrieval call binding the contract method 0x6c6f99a3.
+//
+// Solidity: function contentLicenses(bytes32 , uint256 ) view returns(uint256)
+func (_LicenZLicense *LicenZLicenseSession) ContentLicenses(arg0 [32]byte, arg1 *big.Int) (*big.Int, error) {
+	return _LicenZLicense.Contract.ContentLicenses(&_LicenZLicense.CallOpts, arg0, arg1)
+}
+
+// ContentLicenses is a free data retrieval call binding the contract method 0x6c6f99a3.
+//
+// Solidity: function contentLicenses(bytes32 , uint256 ) view returns(uint256)
+func (_LicenZLicense *LicenZLicenseCallerSession) ContentLicenses(arg0 [32]byte, arg1 *big.Int) (*big.Int, error) {
+	return _LicenZLicense.Contract.ContentLicenses(&_LicenZLicense.CallOpts, arg0, arg1)
+}
+
+// CreatorLicenses is a free data retrieval call binding the contract method 0x397a7045.
+//
+// Solidity: function creatorLicenses(address , uint256 ) view returns(uint256)
+func (_LicenZLicense *LicenZLicenseCaller) CreatorLicenses(opts *bind.CallOpts, arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZLicense.contract.Call(opts, &out, "creatorLicenses", arg0, arg1)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CreatorLicenses is a free data retrieval call binding the contract method 0x397a7045.
+//
+// Solidity: function creatorLicenses(address , uint256 ) view returns(uint256)
+func (_LicenZLicense *LicenZLicenseSession) CreatorLicenses(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _LicenZLicense.Contract.CreatorLicenses(&_LicenZLicense.CallOpts, arg0, arg1)
+}
+
+// CreatorLicenses is a free data retrieval call binding the contract method 0x397a7045.
+//
+// Solidity: function creatorLicenses(address , uint256 ) view returns(uint256)
+func (_LicenZLicense *LicenZLicenseCallerSession) CreatorLicenses(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _LicenZLicense.Contract.CreatorLicenses(&_LicenZLicense.CallOpts, arg0, arg1)
+}
+
+// GetContractBalance is a free data retrieval call binding the contract method 0x6f9fb98a.
+//
+// Solidity: function getContractBalance() view returns(uint256)
+func (_LicenZLicense *LicenZLicenseCaller) GetContractBalance(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZLicense.contract.Call(opts, &out, "getContractBalance")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetContractBalance is a free data retrieval call binding the contract method 0x6f9fb98a.
+//
+// Solidity: function getContractBalance() view returns(uint256)
+func (_LicenZLicense *LicenZLicenseSession) GetContractBalance() (*big.Int, error) {
+	return _LicenZLicense.Contract.GetContractBalance(&_LicenZLicense.CallOpts)
+}
+
+// GetContractBalance is a free data retrieval call binding the contract method 0x6f9fb98a.
+//
+// Solidity: function getContractBalance() view returns(uint256)
+func (_LicenZLicense *LicenZLicenseCallerSession) GetContractBalance() (*big.Int, error) {
+	return _LicenZLicense.Contract.GetContractBalance(&_LicenZLicense.CallOpts)
+}
+
+// GetLicense is a free data retrieval call binding the contract method 0xb2dd1212.
+//
+// Solidity: function getLicense(uint256 licenseId) view returns((uint256,bytes32,uint256,string,address,bool,uint256,uint256,address,bool))
+func (_LicenZLicense *LicenZLicenseCaller) GetLicense(opts *bind.CallOpts, licenseId *big.Int) (LicenZLicenseLicense, error) {
+	var out []interface{}
+	err := _LicenZLicense.contract.Call(opts, &out, "getLicense", licenseId)
+
+	if err != nil {
+		return *new(LicenZLicenseLicense), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(LicenZLicenseLicense)).(*LicenZLicenseLicense)
+
+	return out0, err
+
+}
+
+// GetLicense is a free data retrieval call binding the contract method 0xb2dd1212.
+//
+// Solidity: function getLicense(uint256 licenseId) view returns((uint256,bytes32,uint256,string,address,bool,uint256,uint256,address,bool))
+func (_LicenZLicense *LicenZLicenseSession) GetLicense(licenseId *big.Int) (LicenZLicenseLicense, error) {
+	return _LicenZLicense.Contract.GetLicense(&_LicenZLicense.CallOpts, licenseId)
+}
+
+// GetLicense is a free data retrieval call binding the contract method 0xb2dd1212.
+//
+// Solidity: function getLicense(uint256 licenseId) view returns((uint256,bytes32,uint256,string,address,bool,uint256,uint256,address,bool))
+func (_LicenZLicense *LicenZLicenseCallerSession) GetLicense(licenseId *big.Int) (LicenZLicenseLicense, error) {
+	return _LicenZLicense.Contract.GetLicense(&_LicenZLicense.CallOpts, licenseId)
+}
+
+// GetLicensesByCreator is a free data retrieval call binding the contract method 0x06902ba2.
+//
+// Solidity: function getLicensesByCreator(address creator) view returns(uint256[])
+func (_LicenZLicense *LicenZLicenseCaller) GetLicensesByCreator(opts *bind.CallOpts, creator common.Address) ([]*big.Int, error) {
+	var out []interface{}
+	err := _LicenZLicense.contract.Call(opts, &out, "getLicensesByCreator", creator)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetLicensesByCreator is a free data retrieval call binding the contract method 0x06902ba2.
+//
+// Solidity: function getLicensesByCreator(address creator) view returns(uint256[])
+func (_LicenZLicense *LicenZLicenseSession) GetLicensesByCreator(creator common.Address) ([]*big.Int, error) {
+	return _LicenZLicense.Contract.GetLicensesByCreator(&_LicenZLicense.CallOpts, creator)
+}
+
+// GetLicensesByCreator is a free data retrieval call binding the contract method 0x06902ba2.
+//
+// Solidity: function getLicensesByCreator(address creator) view returns(uint256[])
+func (_LicenZLicense *LicenZLicenseCallerSession) GetLicensesByCreator(creator common.Address) ([]*big.Int, error) {
+	return _LicenZLicense.Contract.GetLicensesByCreator(&_LicenZLicense.CallOpts, creator)
+}
+
+// GetLicensesByPurchaser is a free data retrieval call binding the contract method 0x20c6e5a8.
+//
+// Solidity: function getLicensesByPurchaser(address purchaser) view returns(uint256[])
+func (_LicenZLicense *LicenZLicenseCaller) GetLicensesByPurchaser(opts *bind.CallOpts, purchaser common.Address) ([]*big.Int, error) {
+	var out []interface{}
+	err := _LicenZLicense.contract.Call(opts, &out, "getLicensesByPurchaser", purchaser)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetLicensesByPurchaser is a free data retrieval call binding the contract method 0x20c6e5a8.
+//
+// Solidity: function getLicensesByPurchaser(address purchaser) view returns(uint256[])
+func (_LicenZLicense *LicenZLicenseSession) GetLicensesByPurchaser(purchaser common.Address) ([]*big.Int, error) {
+	return _LicenZLicense.Contract.GetLicensesByPurchaser(&_LicenZLicense.CallOpts, purchaser)
+}
+
+// GetLicensesByPurchaser is a free data retrieval call binding the contract method 0x20c6e5a8.
+//
+// Solidity: function getLicensesByPurchaser(address purchaser) view returns(uint256[])
+func (_LicenZLicense *LicenZLicenseCallerSession) GetLicensesByPurchaser(purchaser common.Address) ([]*big.Int, error) {
+	return _LicenZLicense.Contract.GetLicensesByPurchaser(&_LicenZLicense.CallOpts, purchaser)
+}
+
+// GetLicensesForContent is a free data retrieval call binding the contract method 0xffd940d9.
+//
+// Solidity: function getLicensesForContent(bytes32 contentHash) view returns(uint256[])
+func (_LicenZLicense *LicenZLicenseCaller) GetLicensesForContent(opts *bind.CallOpts, contentHash [32]byte) ([]*big.Int, error) {
+	var out []interface{}
+	err := _LicenZLicense.contract.Call(opts, &out, "getLicensesForContent", contentHash)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetLicensesForContent is a free data retrieval call binding the contract method 0xffd940d9.
+//
+// Solidity: function getLicensesForContent(bytes32 contentHash) view returns(uint256[])
+func (_LicenZLicense *LicenZLicenseSession) GetLicensesForContent(contentHash [32]byte) ([]*big.Int, error) {
+	return _LicenZLicense.Contract.GetLicensesForContent(&_LicenZLicense.CallOpts, contentHash)
+}
+
+// GetLicensesForContent is a free data retrieval call binding the contract method 0xffd940d9.
+//
+// Solidity: function getLicensesForContent(bytes32 contentHash) view returns(uint256[])
+func (_LicenZLicense *LicenZLicenseCallerSession) GetLicensesForContent(contentHash [32]byte) ([]*big.Int, error) {
+	return _LicenZLicense.Contract.GetLicensesForContent(&_LicenZLicense.CallOpts, contentHash)
+}
+
+// IsLicenseValid is a free data retrieval call binding the contract method 0x9f7fc6d3.
+//
+// Solidity: function isLicenseValid(uint256 licenseId) view returns(bool)
+func (_LicenZLicense *LicenZLicenseCaller) IsLicenseValid(opts *bind.CallOpts, licenseId *big.Int) (bool, error) {
+	var out []interface{}
+	err := _LicenZLicense.contract.Call(opts, &out, "isLicenseValid", licenseId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsLicenseValid is a free data retrieval call binding the contract method 0x9f7fc6d3.
+//
+// Solidity: function isLicenseValid(uint256 licenseId) view returns(bool)
+func (_LicenZLicense *LicenZLicenseSession) IsLicenseValid(licenseId *big.Int) (bool, error) {
+	return _LicenZLicense.Contract.IsLicenseValid(&_LicenZLicense.CallOpts, licenseId)
+}
+
+// IsLicenseValid is a free data retrieval call binding the contract method 0x9f7fc6d3.
+//
+// Solidity: function isLicenseValid(uint256 licenseId) view returns(bool)
+func (_LicenZLicense *LicenZLicenseCallerSession) IsLicenseValid(licenseId *big.Int) (bool, error) {
+	return _LicenZLicense.Contract.IsLicenseValid(&_LicenZLicense.CallOpts, licenseId)
+}
+
+// Licenses is a free data retrieval call binding the contract method 0x33790845.
+//
+// Solidity: function licenses(uint256 ) view returns(uint256 licenseId, bytes32 contentHash, uint256 price, string terms, address creator, bool isActive, uint256 createdAt, uint256 purchasedAt, address purchaser, bool isPurchased)
+func (_LicenZLicense *LicenZLicenseCaller) Licenses(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	LicenseId   *big.Int
+	ContentHash [32]byte
+	Price       *big.Int
+	Terms       string
+	Creator     common.Address
+	IsActive    bool
+	CreatedAt   *big.Int
+	PurchasedAt *big.Int
+	Purchaser   common.Address
+	IsPurchased bool
+}, error) {
+	var out []interface{}
+	err := _LicenZLicense.contract.Call(opts, &out, "licenses", arg0)
+
+	outstruct := new(struct {
+		LicenseId   *big.Int
+		ContentHash [32]byte
+		Price       *big.Int
+		Terms       string
+		Creator     common.Address
+		IsActive    bool
+		CreatedAt   *big.Int
+		PurchasedAt *big.Int
+		Purchaser   common.Address
+		IsPurchased bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.LicenseId = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.ContentHash = *abi.ConvertType(out[1], new([32]byte)).(*[32]byte)
+	outstruct.Price = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.Terms = *abi.ConvertType(out[3], new(string)).(*string)
+	outstruct.Creator = *abi.ConvertType(out[4], new(common.Address)).(*common.Address)
+	outstruct.IsActive = *abi.ConvertType(out[5], new(bool)).(*bool)
+	outstruct.CreatedAt = *abi.ConvertType(out[6], new(*big.Int)).(**big.Int)
+	outstruct.PurchasedAt = *abi.ConvertType(out[7], new(*big.Int)).(**big.Int)
+	outstruct.Purchaser = *abi.ConvertType(out[8], new(common.Address)).(*common.Address)
+	outstruct.IsPurchased = *abi.ConvertType(out[9], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// Licenses is a free data retrieval call binding the contract method 0x33790845.
+//
+// Solidity: function licenses(uint256 ) view returns(uint256 licenseId, bytes32 contentHash, uint256 price, string terms, address creator, bool isActive, uint256 createdAt, uint256 purchasedAt, address purchaser, bool isPurchased)
+func (_LicenZLicense *LicenZLicenseSession) Licenses(arg0 *big.Int) (struct {
+	LicenseId   *big.Int
+	ContentHash [32]byte
+	Price       *big.Int
+	Terms       string
+	Creator     common.Address
+	IsActive    bool
+	CreatedAt   *big.Int
+	PurchasedAt *big.Int
+	Purchaser   common.Address
+	IsPurchased bool
+}, error) {
+	return _LicenZLicense.Contract.Licenses(&_LicenZLicense.CallOpts, arg0)
+}
+
+// Licenses is a free data retrieval call binding the contract method 0x33790845.
+//
+// Solidity: function licenses(uint256 ) view returns(uint256 licenseId, bytes32 contentHash, uint256 price, string terms, address creator, bool isActive, uint256 createdAt, uint256 purchasedAt, address purchaser, bool isPurchased)
+func (_LicenZLicense *LicenZLicenseCallerSession) Licenses(arg0 *big.Int) (struct {
+	LicenseId   *big.Int
+	ContentHash [32]byte
+	Price       *big.Int
+	Terms       string
+	Creator     common.Address
+	IsActive    bool
+	CreatedAt   *big.Int
+	PurchasedAt *big.Int
+	Purchaser   common.Address
+	IsPurchased bool
+}, error) {
+	return _LicenZLicense.Contract.Licenses(&_LicenZLicense.CallOpts, arg0)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LicenZLicense *LicenZLicenseCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _LicenZLicense.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LicenZLicense *LicenZLicenseSession) Owner() (common.Address, error) {
+	return _LicenZLicense.Contract.Owner(&_LicenZLicense.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LicenZLicense *LicenZLicenseCallerSession) Owner() (common.Address, error) {
+	return _LicenZLicense.Contract.Owner(&_LicenZLicense.CallOpts)
+}
+
+// PlatformFeePercentage is a free data retrieval call binding the contract method 0xcdd78cfc.
+//
+// Solidity: function platformFeePercentage() view returns(uint256)
+func (_LicenZLicense *LicenZLicenseCaller) PlatformFeePercentage(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZLicense.contract.Call(opts, &out, "platformFeePercentage")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PlatformFeePercentage is a free data retrieval call binding the contract method 0xcdd78cfc.
+//
+// Solidity: function platformFeePercentage() view returns(uint256)
+func (_LicenZLicense *LicenZLicenseSession) PlatformFeePercentage() (*big.Int, error) {
+	return _LicenZLicense.Contract.PlatformFeePercentage(&_LicenZLicense.CallOpts)
+}
+
+// PlatformFeePercentage is a free data retrieval call binding the contract method 0xcdd78cfc.
+//
+// Solidity: function platformFeePercentage() view returns(uint256)
+func (_LicenZLicense *LicenZLicenseCallerSession) PlatformFeePercentage() (*big.Int, error) {
+	return _LicenZLicense.Contract.PlatformFeePercentage(&_LicenZLicense.CallOpts)
+}
+
+// PurchaserLicenses is a free data retrieval call binding the contract method 0x9731be00.
+//
+// Solidity: function purchaserLicenses(address , uint256 ) view returns(uint256)
+func (_LicenZLicense *LicenZLicenseCaller) PurchaserLicenses(opts *bind.CallOpts, arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZLicense.contract.Call(opts, &out, "purchaserLicenses", arg0, arg1)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PurchaserLicenses is a free data retrieval call binding the contract method 0x9731be00.
+//
+// Solidity: function purchaserLicenses(address , uint256 ) view returns(uint256)
+func (_LicenZLicense *LicenZLicenseSession) PurchaserLicenses(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _LicenZLicense.Contract.PurchaserLicenses(&_LicenZLicense.CallOpts, arg0, arg1)
+}
+
+// PurchaserLicenses is a free data retrieval call binding the contract method 0x9731be00.
+//
+// Solidity: function purchaserLicenses(address , uint256 ) view returns(uint256)
+func (_LicenZLicense *LicenZLicenseCallerSession) PurchaserLicenses(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _LicenZLicense.Contract.PurchaserLicenses(&_LicenZLicense.CallOpts, arg0, arg1)
+}
+
+// TotalLicenses is a free data retrieval call binding the contract method 0x0e80a287.
+//
+// Solidity: function totalLicenses() view returns(uint256)
+func (_LicenZLicense *LicenZLicenseCaller) TotalLicenses(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZLicense.contract.Call(opts, &out, "totalLicenses")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalLicenses is a free data retrieval call binding the contract method 0x0e80a287.
+//
+// Solidity: function totalLicenses() view returns(uint256)
+func (_LicenZLicense *LicenZLicenseSession) TotalLicenses() (*big.Int, error) {
+	return _LicenZLicense.Contract.TotalLicenses(&_LicenZLicense.CallOpts)
+}
+
+// TotalLicenses is a free data retrieval call binding the contract method 0x0e80a287.
+//
+// Solidity: function totalLicenses() view returns(uint256)
+func (_LicenZLicense *LicenZLicenseCallerSession) TotalLicenses() (*big.Int, error) {
+	return _LicenZLicense.Contract.TotalLicenses(&_LicenZLicense.CallOpts)
+}
+
+// CreateLicense is a paid mutator transaction binding the contract method 0x58097678.
+//
+// Solidity: function createLicense(bytes32 contentHash, uint256 price, string terms) returns(uint256)
+func (_LicenZLicense *LicenZLicenseTransactor) CreateLicense(opts *bind.TransactOpts, contentHash [32]byte, price *big.Int, terms string) (*types.Transaction, error) {
+	return _LicenZLicense.contract.Transact(opts, "createLicense", contentHash, price, terms)
+}
+
+// CreateLicense is a paid mutator transaction binding the contract method 0x58097678.
+//
+// Solidity: function createLicense(bytes32 contentHash, uint256 price, string terms) returns(uint256)
+func (_LicenZLicense *LicenZLicenseSession) CreateLicense(contentHash [32]byte, price *big.Int, terms string) (*types.Transaction, error) {
+	return _LicenZLicense.Contract.CreateLicense(&_LicenZLicense.TransactOpts, contentHash, price, terms)
+}
+
+// CreateLicense is a paid mutator transaction binding the contract method 0x58097678.
+//
+// Solidity: function createLicense(bytes32 contentHash, uint256 price, string terms) returns(uint256)
+func (_LicenZLicense *LicenZLicenseTransactorSession) CreateLicense(contentHash [32]byte, price *big.Int, terms string) (*types.Transaction, error) {
+	return _LicenZLicense.Contract.CreateLicense(&_LicenZLicense.TransactOpts, contentHash, price, terms)
+}
+
+// DeactivateLicense is a paid mutator transaction binding the contract method 0x662d3363.
+//
+// Solidity: function deactivateLicense(uint256 licenseId) returns()
+func (_LicenZLicense *LicenZLicenseTransactor) DeactivateLicense(opts *bind.TransactOpts, licenseId *big.Int) (*types.Transaction, error) {
+	return _LicenZLicense.contract.Transact(opts, "deactivateLicense", licenseId)
+}
+
+// DeactivateLicense is a paid mutator transaction binding the contract method 0x662d3363.
+//
+// Solidity: function deactivateLicense(uint256 licenseId) returns()
+func (_LicenZLicense *LicenZLicenseSession) DeactivateLicense(licenseId *big.Int) (*types.Transaction, error) {
+	return _LicenZLicense.Contract.DeactivateLicense(&_LicenZLicense.TransactOpts, licenseId)
+}
+
+// DeactivateLicense is a paid mutator transaction binding the contract method 0x662d3363.
+//
+// Solidity: function deactivateLicense(uint256 licenseId) returns()
+func (_LicenZLicense *LicenZLicenseTransactorSession) DeactivateLicense(licenseId *big.Int) (*types.Transaction, error) {
+	return _LicenZLicense.Contract.DeactivateLicense(&_LicenZLicense.TransactOpts, licenseId)
+}
+
+// PurchaseLicense is a paid mutator transaction binding the contract method 0xc8a028a8.
+//
+// Solidity: function purchaseLicense(uint256 licenseId) payable returns()
+func (_LicenZLicense *LicenZLicenseTransactor) PurchaseLicense(opts *bind.TransactOpts, licenseId *big.Int) (*types.Transaction, error) {
+	return _LicenZLicense.contract.Transact(opts, "purchaseLicense", licenseId)
+}
+
+// PurchaseLicense is a paid mutator transaction binding the contract method 0xc8a028a8.
+//
+// Solidity: function purchaseLicense(uint256 licenseId) payable returns()
+func (_LicenZLicense *LicenZLicenseSession) PurchaseLicense(licenseId *big.Int) (*types.Transaction, error) {
+	return _LicenZLicense.Contract.PurchaseLicense(&_LicenZLicense.TransactOpts, licenseId)
+}
+
+// PurchaseLicense is a paid mutator transaction binding the contract method 0xc8a028a8.
+//
+// Solidity: function purchaseLicense(uint256 licenseId) payable returns()
+func (_LicenZLicense *LicenZLicenseTransactorSession) PurchaseLicense(licenseId *big.Int) (*types.Transaction, error) {
+	return _LicenZLicense.Contract.PurchaseLicense(&_LicenZLicense.TransactOpts, licenseId)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LicenZLicense *LicenZLicenseTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LicenZLicense.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LicenZLicense *LicenZLicenseSession) RenounceOwnership() (*types.Transaction, error) {
+	return _LicenZLicense.Contract.RenounceOwnership(&_LicenZLicense.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LicenZLicense *LicenZLicenseTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _LicenZLicense.Contract.RenounceOwnership(&_LicenZLicense.TransactOpts)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LicenZLicense *LicenZLicenseTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _LicenZLicense.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LicenZLicense *LicenZLicenseSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LicenZLicense.Contract.TransferOwnership(&_LicenZLicense.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LicenZLicense *LicenZLicenseTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LicenZLicense.Contract.TransferOwnership(&_LicenZLicense.TransactOpts, newOwner)
+}
+
+// UpdatePlatformFee is a paid mutator transaction binding the contract method 0xaa0b5988.
+//
+// Solidity: function updatePlatformFee(uint256 newFeePercentage) returns()
+func (_LicenZLicense *LicenZLicenseTransactor) UpdatePlatformFee(opts *bind.TransactOpts, newFeePercentage *big.Int) (*types.Transaction, error) {
+	return _LicenZLicense.contract.Transact(opts, "updatePlatformFee", newFeePercentage)
+}
+
+// UpdatePlatformFee is a paid mutator transaction binding the contract method 0xaa0b5988.
+//
+// Solidity: function updatePlatformFee(uint256 newFeePercentage) returns()
+func (_LicenZLicense *LicenZLicenseSession) UpdatePlatformFee(newFeePercentage *big.Int) (*types.Transaction, error) {
+	return _LicenZLicense.Contract.UpdatePlatformFee(&_LicenZLicense.TransactOpts, newFeePercentage)
+}
+
+// UpdatePlatformFee is a paid mutator transaction binding the contract method 0xaa0b5988.
+//
+// Solidity: function updatePlatformFee(uint256 newFeePercentage) returns()
+func (_LicenZLicense *LicenZLicenseTransactorSession) UpdatePlatformFee(newFeePercentage *big.Int) (*types.Transaction, error) {
+	return _LicenZLicense.Contract.UpdatePlatformFee(&_LicenZLicense.TransactOpts, newFeePercentage)
+}
+
+// WithdrawPlatformFees is a paid mutator transaction binding the contract method 0xd0b7830b.
+//
+// Solidity: function withdrawPlatformFees() returns()
+func (_LicenZLicense *LicenZLicenseTransactor) WithdrawPlatformFees(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LicenZLicense.contract.Transact(opts, "withdrawPlatformFees")
+}
+
+// WithdrawPlatformFees is a paid mutator transaction binding the contract method 0xd0b7830b.
+//
+// Solidity: function withdrawPlatformFees() returns()
+func (_LicenZLicense *LicenZLicenseSession) WithdrawPlatformFees() (*types.Transaction, error) {
+	return _LicenZLicense.Contract.WithdrawPlatformFees(&_LicenZLicense.TransactOpts)
+}
+
+// WithdrawPlatformFees is a paid mutator transaction binding the contract method 0xd0b7830b.
+//
+// Solidity: function withdrawPlatformFees() returns()
+func (_LicenZLicense *LicenZLicenseTransactorSession) WithdrawPlatformFees() (*types.Transaction, error) {
+	return _LicenZLicense.Contract.WithdrawPlatformFees(&_LicenZLicense.TransactOpts)
+}
+
+// LicenZLicenseLicenseCreatedIterator is returned from FilterLicenseCreated and is used to iterate over the raw logs and unpacked data for LicenseCreated events raised by the LicenZLicense contract.
+type LicenZLicenseLicenseCreatedIterator struct {
+	Event *LicenZLicenseLicenseCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZLicenseLicenseCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZLicenseLicenseCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZLicenseLicenseCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZLicenseLicenseCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZLicenseLicenseCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZLicenseLicenseCreated represents a LicenseCreated event raised by the LicenZLicense contract.
+type LicenZLicenseLicenseCreated struct {
+	LicenseId   *big.Int
+	ContentHash [32]byte
+	Creator     common.Address
+	Price       *big.Int
+	Terms       string
+	Timestamp   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterLicenseCreated is a free log retrieval operation binding the contract event 0x0f72749572126d813c3ae8f68de74d59515d014eb65546d55fc6635013263059.
+//
+// Solidity: event LicenseCreated(uint256 indexed licenseId, bytes32 indexed contentHash, address indexed creator, uint256 price, string terms, uint256 timestamp)
+func (_LicenZLicense *LicenZLicenseFilterer) FilterLicenseCreated(opts *bind.FilterOpts, licenseId []*big.Int, contentHash [][32]byte, creator []common.Address) (*LicenZLicenseLicenseCreatedIterator, error) {
+
+	var licenseIdRule []interface{}
+	for _, licenseIdItem := range licenseId {
+		licenseIdRule = append(licenseIdRule, licenseIdItem)
+	}
+	var contentHashRule []interface{}
+	for _, contentHashItem := range contentHash {
+		contentHashRule = append(contentHashRule, contentHashItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _LicenZLicense.contract.FilterLogs(opts, "LicenseCreated", licenseIdRule, contentHashRule, creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZLicenseLicenseCreatedIterator{contract: _LicenZLicense.contract, event: "LicenseCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchLicenseCreated is a free log subscription operation binding the contract event 0x0f72749572126d813c3ae8f68de74d59515d014eb65546d55fc6635013263059.
+//
+// Solidity: event LicenseCreated(uint256 indexed licenseId, bytes32 indexed contentHash, address indexed creator, uint256 price, string terms, uint256 timestamp)
+func (_LicenZLicense *LicenZLicenseFilterer) WatchLicenseCreated(opts *bind.WatchOpts, sink chan<- *LicenZLicenseLicenseCreated, licenseId []*big.Int, contentHash [][32]byte, creator []common.Address) (event.Subscription, error) {
+
+	var licenseIdRule []interface{}
+	for _, licenseIdItem := range licenseId {
+		licenseIdRule = append(licenseIdRule, licenseIdItem)
+	}
+	var contentHashRule []interface{}
+	for _, contentHashItem := range contentHash {
+		contentHashRule = append(contentHashRule, contentHashItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _LicenZLicense.contract.WatchLogs(opts, "LicenseCreated", licenseIdRule, contentHashRule, creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZLicenseLicenseCreated)
+				if err := _LicenZLicense.contract.UnpackLog(event, "LicenseCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLicenseCreated is a log parse operation binding the contract event 0x0f72749572126d813c3ae8f68de74d59515d014eb65546d55fc6635013263059.
+//
+// Solidity: event LicenseCreated(uint256 indexed licenseId, bytes32 indexed contentHash, address indexed creator, uint256 price, string terms, uint256 timestamp)
+func (_LicenZLicense *LicenZLicenseFilterer) ParseLicenseCreated(log types.Log) (*LicenZLicenseLicenseCreated, error) {
+	event := new(LicenZLicenseLicenseCreated)
+	if err := _LicenZLicense.contract.UnpackLog(event, "LicenseCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZLicenseLicenseDeactivatedIterator is returned from FilterLicenseDeactivated and is used to iterate over the raw logs and unpacked data for LicenseDeactivated events raised by the LicenZLicense contract.
+type LicenZLicenseLicenseDeactivatedIterator struct {
+	Event *LicenZLicenseLicenseDeactivated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZLicenseLicenseDeactivatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZLicenseLicenseDeactivated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZLicenseLicenseDeactivated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZLicenseLicenseDeactivatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZLicenseLicenseDeactivatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZLicenseLicenseDeactivated represents a LicenseDeactivated event raised by the LicenZLicense contract.
+type LicenZLicenseLicenseDeactivated struct {
+	LicenseId *big.Int
+	Creator   common.Address
+	Timestamp *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterLicenseDeactivated is a free log retrieval operation binding the contract event 0x1aed6e3b013b3efb26259a30903263f7465afcfe5c1828a06739cb80738c917e.
+//
+// Solidity: event LicenseDeactivated(uint256 indexed licenseId, address indexed creator, uint256 timestamp)
+func (_LicenZLicense *LicenZLicenseFilterer) FilterLicenseDeactivated(opts *bind.FilterOpts, licenseId []*big.Int, creator []common.Address) (*LicenZLicenseLicenseDeactivatedIterator, error) {
+
+	var licenseIdRule []interface{}
+	for _, licenseIdItem := range licenseId {
+		licenseIdRule = append(licenseIdRule, licenseIdItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _LicenZLicense.contract.FilterLogs(opts, "LicenseDeactivated", licenseIdRule, creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZLicenseLicenseDeactivatedIterator{contract: _LicenZLicense.contract, event: "LicenseDeactivated", logs: logs, sub: sub}, nil
+}
+
+// WatchLicenseDeactivated is a free log subscription operation binding the contract event 0x1aed6e3b013b3efb26259a30903263f7465afcfe5c1828a06739cb80738c917e.
+//
+// Solidity: event LicenseDeactivated(uint256 indexed licenseId, address indexed creator, uint256 timestamp)
+func (_LicenZLicense *LicenZLicenseFilterer) WatchLicenseDeactivated(opts *bind.WatchOpts, sink chan<- *LicenZLicenseLicenseDeactivated, licenseId []*big.Int, creator []common.Address) (event.Subscription, error) {
+
+	var licenseIdRule []interface{}
+	for _, licenseIdItem := range licenseId {
+		licenseIdRule = append(licenseIdRule, licenseIdItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _LicenZLicense.contract.WatchLogs(opts, "LicenseDeactivated", licenseIdRule, creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZLicenseLicenseDeactivated)
+				if err := _LicenZLicense.contract.UnpackLog(event, "LicenseDeactivated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLicenseDeactivated is a log parse operation binding the contract event 0x1aed6e3b013b3efb26259a30903263f7465afcfe5c1828a06739cb80738c917e.
+//
+// Solidity: event LicenseDeactivated(uint256 indexed licenseId, address indexed creator, uint256 timestamp)
+func (_LicenZLicense *LicenZLicenseFilterer) ParseLicenseDeactivated(log types.Log) (*LicenZLicenseLicenseDeactivated, error) {
+	event := new(LicenZLicenseLicenseDeactivated)
+	if err := _LicenZLicense.contract.UnpackLog(event, "LicenseDeactivated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZLicenseLicensePurchasedIterator is returned from FilterLicensePurchased and is used to iterate over the raw logs and unpacked data for LicensePurchased events raised by the LicenZLicense contract.
+type LicenZLicenseLicensePurchasedIterator struct {
+	Event *LicenZLicenseLicensePurchased // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZLicenseLicensePurchasedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZLicenseLicensePurchased)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZLicenseLicensePurchased)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZLicenseLicensePurchasedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZLicenseLicensePurchasedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZLicenseLicensePurchased represents a LicensePurchased event raised by the LicenZLicense contract.
+type LicenZLicenseLicensePurchased struct {
+	LicenseId *big.Int
+	Purchaser common.Address
+	Price     *big.Int
+	Timestamp *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterLicensePurchased is a free log retrieval operation binding the contract event 0x111e63cec6aa97bd94b0ad8d283ae501bfd3dfbefb6d9a2d5391368ab5fb7695.
+//
+// Solidity: event LicensePurchased(uint256 indexed licenseId, address indexed purchaser, uint256 price, uint256 timestamp)
+func (_LicenZLicense *LicenZLicenseFilterer) FilterLicensePurchased(opts *bind.FilterOpts, licenseId []*big.Int, purchaser []common.Address) (*LicenZLicenseLicensePurchasedIterator, error) {
+
+	var licenseIdRule []interface{}
+	for _, licenseIdItem := range licenseId {
+		licenseIdRule = append(licenseIdRule, licenseIdItem)
+	}
+	var purchaserRule []interface{}
+	for _, purchaserItem := range purchaser {
+		purchaserRule = append(purchaserRule, purchaserItem)
+	}
+
+	logs, sub, err := _LicenZLicense.contract.FilterLogs(opts, "LicensePurchased", licenseIdRule, purchaserRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZLicenseLicensePurchasedIterator{contract: _LicenZLicense.contract, event: "LicensePurchased", logs: logs, sub: sub}, nil
+}
+
+// WatchLicensePurchased is a free log subscription operation binding the contract event 0x111e63cec6aa97bd94b0ad8d283ae501bfd3dfbefb6d9a2d5391368ab5fb7695.
+//
+// Solidity: event LicensePurchased(uint256 indexed licenseId, address indexed purchaser, uint256 price, uint256 timestamp)
+func (_LicenZLicense *LicenZLicenseFilterer) WatchLicensePurchased(opts *bind.WatchOpts, sink chan<- *LicenZLicenseLicensePurchased, licenseId []*big.Int, purchaser []common.Address) (event.Subscription, error) {
+
+	var licenseIdRule []interface{}
+	for _, licenseIdItem := range licenseId {
+		licenseIdRule = append(licenseIdRule, licenseIdItem)
+	}
+	var purchaserRule []interface{}
+	for _, purchaserItem := range purchaser {
+		purchaserRule = append(purchaserRule, purchaserItem)
+	}
+
+	logs, sub, err := _LicenZLicense.contract.WatchLogs(opts, "LicensePurchased", licenseIdRule, purchaserRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZLicenseLicensePurchased)
+				if err := _LicenZLicense.contract.UnpackLog(event, "LicensePurchased", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLicensePurchased is a log parse operation binding the contract event 0x111e63cec6aa97bd94b0ad8d283ae501bfd3dfbefb6d9a2d5391368ab5fb7695.
+//
+// Solidity: event LicensePurchased(uint256 indexed licenseId, address indexed purchaser, uint256 price, uint256 timestamp)
+func (_LicenZLicense *LicenZLicenseFilterer) ParseLicensePurchased(log types.Log) (*LicenZLicenseLicensePurchased, error) {
+	event := new(LicenZLicenseLicensePurchased)
+	if err := _LicenZLicense.contract.UnpackLog(event, "LicensePurchased", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZLicenseOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the LicenZLicense contract.
+type LicenZLicenseOwnershipTransferredIterator struct {
+	Event *LicenZLicenseOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZLicenseOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZLicenseOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZLicenseOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZLicenseOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZLicenseOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZLicenseOwnershipTransferred represents a OwnershipTransferred event raised by the LicenZLicense contract.
+type LicenZLicenseOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LicenZLicense *LicenZLicenseFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*LicenZLicenseOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LicenZLicense.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZLicenseOwnershipTransferredIterator{contract: _LicenZLicense.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LicenZLicense *LicenZLicenseFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *LicenZLicenseOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LicenZLicense.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZLicenseOwnershipTransferred)
+				if err := _LicenZLicense.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LicenZLicense *LicenZLicenseFilterer) ParseOwnershipTransferred(log types.Log) (*LicenZLicenseOwnershipTransferred, error) {
+	event := new(LicenZLicenseOwnershipTransferred)
+	if err := _LicenZLicense.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZLicensePlatformFeeUpdatedIterator is returned from FilterPlatformFeeUpdated and is used to iterate over the raw logs and unpacked data for PlatformFeeUpdated events raised by the LicenZLicense contract.
+type LicenZLicensePlatformFeeUpdatedIterator struct {
+	Event *LicenZLicensePlatformFeeUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZLicensePlatformFeeUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZLicensePlatformFeeUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZLicensePlatformFeeUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZLicensePlatformFeeUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZLicensePlatformFeeUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZLicensePlatformFeeUpdated represents a PlatformFeeUpdated event raised by the LicenZLicense contract.
+type LicenZLicensePlatformFeeUpdated struct {
+	OldFee    *big.Int
+	NewFee    *big.Int
+	Timestamp *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterPlatformFeeUpdated is a free log retrieval operation binding the contract event 0x50f8ab96ec41b29e295dbc21aa964857bd7ba51c554c209db631610ce392d456.
+//
+// Solidity: event PlatformFeeUpdated(uint256 oldFee, uint256 newFee, uint256 timestamp)
+func (_LicenZLicense *LicenZLicenseFilterer) FilterPlatformFeeUpdated(opts *bind.FilterOpts) (*LicenZLicensePlatformFeeUpdatedIterator, error) {
+
+	logs, sub, err := _LicenZLicense.contract.FilterLogs(opts, "PlatformFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZLicensePlatformFeeUpdatedIterator{contract: _LicenZLicense.contract, event: "PlatformFeeUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchPlatformFeeUpdated is a free log subscription operation binding the contract event 0x50f8ab96ec41b29e295dbc21aa964857bd7ba51c554c209db631610ce392d456.
+//
+// Solidity: event PlatformFeeUpdated(uint256 oldFee, uint256 newFee, uint256 timestamp)
+func (_LicenZLicense *LicenZLicenseFilterer) WatchPlatformFeeUpdated(opts *bind.WatchOpts, sink chan<- *LicenZLicensePlatformFeeUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _LicenZLicense.contract.WatchLogs(opts, "PlatformFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZLicensePlatformFeeUpdated)
+				if err := _LicenZLicense.contract.UnpackLog(event, "PlatformFeeUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePlatformFeeUpdated is a log parse operation binding the contract event 0x50f8ab96ec41b29e295dbc21aa964857bd7ba51c554c209db631610ce392d456.
+//
+// Solidity: event PlatformFeeUpdated(uint256 oldFee, uint256 newFee, uint256 timestamp)
+func (_LicenZLicense *LicenZLicenseFilterer) ParsePlatformFeeUpdated(log types.Log) (*LicenZLicensePlatformFeeUpdated, error) {
+	event := new(LicenZLicensePlatformFeeUpdated)
+	if err := _LicenZLicense.contract.UnpackLog(event, "PlatformFeeUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
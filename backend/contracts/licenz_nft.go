@@ -0,0 +1,2090 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// LicenZNFTMetaData contains all meta data concerning the LicenZNFT contract.
+var LicenZNFTMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_fromTokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_toTokenId\",\"type\":\"uint256\"}],\"name\":\"BatchMetadataUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"oldHash\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newHash\",\"type\":\"bytes32\"}],\"name\":\"ContentHashUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"}],\"name\":\"MetadataUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"contentHash\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"tokenURI\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"NFTMinted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"contentHashToTokenId\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"contentHash\",\"type\":\"bytes32\"}],\"name\":\"getTokenByContentHash\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"creationTime\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"contentHash\",\"type\":\"bytes32\"}],\"name\":\"isContentMinted\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"contentHash\",\"type\":\"bytes32\"},{\"internalType\":\"string\",\"name\":\"uri\",\"type\":\"string\"}],\"name\":\"mintNFT\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"tokenCreationTime\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"tokenCreators\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"tokenIdToContentHash\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// LicenZNFTABI is the input ABI used to generate the binding from.
+// Deprecated: Use LicenZNFTMetaData.ABI instead.
+var LicenZNFTABI = LicenZNFTMetaData.ABI
+
+// LicenZNFT is an auto generated Go binding around an Ethereum contract.
+type LicenZNFT struct {
+	LicenZNFTCaller     // Read-only binding to the contract
+	LicenZNFTTransactor // Write-only binding to the contract
+	LicenZNFTFilterer   // Log filterer for contract events
+}
+
+// LicenZNFTCaller is an auto generated read-only Go binding around an Ethereum contract.
+type LicenZNFTCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LicenZNFTTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type LicenZNFTTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LicenZNFTFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type LicenZNFTFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LicenZNFTSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type LicenZNFTSession struct {
+	Contract     *LicenZNFT        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// LicenZNFTCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type LicenZNFTCallerSession struct {
+	Contract *LicenZNFTCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// LicenZNFTTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type LicenZNFTTransactorSession struct {
+	Contract     *LicenZNFTTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// LicenZNFTRaw is an auto generated low-level Go binding around an Ethereum contract.
+type LicenZNFTRaw struct {
+	Contract *LicenZNFT // Generic contract binding to access the raw methods on
+}
+
+// LicenZNFTCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type LicenZNFTCallerRaw struct {
+	Contract *LicenZNFTCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// LicenZNFTTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type LicenZNFTTransactorRaw struct {
+	Contract *LicenZNFTTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewLicenZNFT creates a new instance of LicenZNFT, bound to a specific deployed contract.
+func NewLicenZNFT(address common.Address, backend bind.ContractBackend) (*LicenZNFT, error) {
+	contract, err := bindLicenZNFT(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZNFT{LicenZNFTCaller: LicenZNFTCaller{contract: contract}, LicenZNFTTransactor: LicenZNFTTransactor{contract: contract}, LicenZNFTFilterer: LicenZNFTFilterer{contract: contract}}, nil
+}
+
+// NewLicenZNFTCaller creates a new read-only instance of LicenZNFT, bound to a specific deployed contract.
+func NewLicenZNFTCaller(address common.Address, caller bind.ContractCaller) (*LicenZNFTCaller, error) {
+	contract, err := bindLicenZNFT(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZNFTCaller{contract: contract}, nil
+}
+
+// NewLicenZNFTTransactor creates a new write-only instance of LicenZNFT, bound to a specific deployed contract.
+func NewLicenZNFTTransactor(address common.Address, transactor bind.ContractTransactor) (*LicenZNFTTransactor, error) {
+	contract, err := bindLicenZNFT(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZNFTTransactor{contract: contract}, nil
+}
+
+// NewLicenZNFTFilterer creates a new log filterer instance of LicenZNFT, bound to a specific deployed contract.
+func NewLicenZNFTFilterer(address common.Address, filterer bind.ContractFilterer) (*LicenZNFTFilterer, error) {
+	contract, err := bindLicenZNFT(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZNFTFilterer{contract: contract}, nil
+}
+
+// bindLicenZNFT binds a generic wrapper to an already deployed contract.
+func bindLicenZNFT(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := LicenZNFTMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LicenZNFT *LicenZNFTRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LicenZNFT.Contract.LicenZNFTCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LicenZNFT *LicenZNFTRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.LicenZNFTTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LicenZNFT *LicenZNFTRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.LicenZNFTTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LicenZNFT *LicenZNFTCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LicenZNFT.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LicenZNFT *LicenZNFTTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LicenZNFT *LicenZNFTTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LicenZNFT *LicenZNFTCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LicenZNFT *LicenZNFTSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _LicenZNFT.Contract.BalanceOf(&_LicenZNFT.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LicenZNFT *LicenZNFTCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _LicenZNFT.Contract.BalanceOf(&_LicenZNFT.CallOpts, owner)
+}
+
+// ContentHashToTokenId is a free data retrieval call binding the contract method 0xf95b09d5.
+//
+// Solidity: function contentHashToTokenId(bytes32 ) view returns(uint256)
+func (_LicenZNFT *LicenZNFTCaller) ContentHashToTokenId(opts *bind.CallOpts, arg0 [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "contentHashToTokenId", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ContentHashToTokenId is a free data retrieval call binding the contract method 0xf95b09d5.
+//
+// Solidity: function contentHashToTokenId(bytes32 ) view returns(uint256)
+func (_LicenZNFT *LicenZNFTSession) ContentHashToTokenId(arg0 [32]byte) (*big.Int, error) {
+	return _LicenZNFT.Contract.ContentHashToTokenId(&_LicenZNFT.CallOpts, arg0)
+}
+
+// ContentHashToTokenId is a free data retrieval call binding the contract method 0xf95b09d5.
+//
+// Solidity: function contentHashToTokenId(bytes32 ) view returns(uint256)
+func (_LicenZNFT *LicenZNFTCallerSession) ContentHashToTokenId(arg0 [32]byte) (*big.Int, error) {
+	return _LicenZNFT.Contract.ContentHashToTokenId(&_LicenZNFT.CallOpts, arg0)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LicenZNFT *LicenZNFTCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LicenZNFT *LicenZNFTSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _LicenZNFT.Contract.GetApproved(&_LicenZNFT.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LicenZNFT *LicenZNFTCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _LicenZNFT.Contract.GetApproved(&_LicenZNFT.CallOpts, tokenId)
+}
+
+// GetTokenByContentHash is a free data retrieval call binding the contract method 0xf6bee0b7.
+//
+// Solidity: function getTokenByContentHash(bytes32 contentHash) view returns(uint256 tokenId, address creator, uint256 creationTime)
+func (_LicenZNFT *LicenZNFTCaller) GetTokenByContentHash(opts *bind.CallOpts, contentHash [32]byte) (struct {
+	TokenId      *big.Int
+	Creator      common.Address
+	CreationTime *big.Int
+}, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "getTokenByContentHash", contentHash)
+
+	outstruct := new(struct {
+		TokenId      *big.Int
+		Creator      common.Address
+		CreationTime *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.TokenId = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Creator = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.CreationTime = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetTokenByContentHash is a free data retrieval call binding the contract method 0xf6bee0b7.
+//
+// Solidity: function getTokenByContentHash(bytes32 contentHash) view returns(uint256 tokenId, address creator, uint256 creationTime)
+func (_LicenZNFT *LicenZNFTSession) GetTokenByContentHash(contentHash [32]byte) (struct {
+	TokenId      *big.Int
+	Creator      common.Address
+	CreationTime *big.Int
+}, error) {
+	return _LicenZNFT.Contract.GetTokenByContentHash(&_LicenZNFT.CallOpts, contentHash)
+}
+
+// GetTokenByContentHash is a free data retrieval call binding the contract method 0xf6bee0b7.
+//
+// Solidity: function getTokenByContentHash(bytes32 contentHash) view returns(uint256 tokenId, address creator, uint256 creationTime)
+func (_LicenZNFT *LicenZNFTCallerSession) GetTokenByContentHash(contentHash [32]byte) (struct {
+	TokenId      *big.Int
+	Creator      common.Address
+	CreationTime *big.Int
+}, error) {
+	return _LicenZNFT.Contract.GetTokenByContentHash(&_LicenZNFT.CallOpts, contentHash)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LicenZNFT *LicenZNFTCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LicenZNFT *LicenZNFTSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _LicenZNFT.Contract.IsApprovedForAll(&_LicenZNFT.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LicenZNFT *LicenZNFTCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _LicenZNFT.Contract.IsApprovedForAll(&_LicenZNFT.CallOpts, owner, operator)
+}
+
+// IsContentMinted is a free data retrieval call binding the contract method 0x81cf7832.
+//
+// Solidity: function isContentMinted(bytes32 contentHash) view returns(bool)
+func (_LicenZNFT *LicenZNFTCaller) IsContentMinted(opts *bind.CallOpts, contentHash [32]byte) (bool, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "isContentMinted", contentHash)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsContentMinted is a free data retrieval call binding the contract method 0x81cf7832.
+//
+// Solidity: function isContentMinted(bytes32 contentHash) view returns(bool)
+func (_LicenZNFT *LicenZNFTSession) IsContentMinted(contentHash [32]byte) (bool, error) {
+	return _LicenZNFT.Contract.IsContentMinted(&_LicenZNFT.CallOpts, contentHash)
+}
+
+// IsContentMinted is a free data retrieval call binding the contract method 0x81cf7832.
+//
+// Solidity: function isContentMinted(bytes32 contentHash) view returns(bool)
+func (_LicenZNFT *LicenZNFTCallerSession) IsContentMinted(contentHash [32]byte) (bool, error) {
+	return _LicenZNFT.Contract.IsContentMinted(&_LicenZNFT.CallOpts, contentHash)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LicenZNFT *LicenZNFTCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LicenZNFT *LicenZNFTSession) Name() (string, error) {
+	return _LicenZNFT.Contract.Name(&_LicenZNFT.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LicenZNFT *LicenZNFTCallerSession) Name() (string, error) {
+	return _LicenZNFT.Contract.Name(&_LicenZNFT.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LicenZNFT *LicenZNFTCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LicenZNFT *LicenZNFTSession) Owner() (common.Address, error) {
+	return _LicenZNFT.Contract.Owner(&_LicenZNFT.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LicenZNFT *LicenZNFTCallerSession) Owner() (common.Address, error) {
+	return _LicenZNFT.Contract.Owner(&_LicenZNFT.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LicenZNFT *LicenZNFTCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LicenZNFT *LicenZNFTSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _LicenZNFT.Contract.OwnerOf(&_LicenZNFT.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LicenZNFT *LicenZNFTCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _LicenZNFT.Contract.OwnerOf(&_LicenZNFT.CallOpts, tokenId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LicenZNFT *LicenZNFTCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LicenZNFT *LicenZNFTSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _LicenZNFT.Contract.SupportsInterface(&_LicenZNFT.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LicenZNFT *LicenZNFTCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _LicenZNFT.Contract.SupportsInterface(&_LicenZNFT.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LicenZNFT *LicenZNFTCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LicenZNFT *LicenZNFTSession) Symbol() (string, error) {
+	return _LicenZNFT.Contract.Symbol(&_LicenZNFT.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LicenZNFT *LicenZNFTCallerSession) Symbol() (string, error) {
+	return _LicenZNFT.Contract.Symbol(&_LicenZNFT.CallOpts)
+}
+
+// TokenCreationTime is a free data retrieval call binding the contract method 0x21e8b17e.
+//
+// Solidity: function tokenCreationTime(uint256 ) view returns(uint256)
+func (_LicenZNFT *LicenZNFTCaller) TokenCreationTime(opts *bind.CallOpts, arg0 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "tokenCreationTime", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenCreationTime is a free data retrieval call binding the contract method 0x21e8b17e.
+//
+// Solidity: function tokenCreationTime(uint256 ) view returns(uint256)
+func (_LicenZNFT *LicenZNFTSession) TokenCreationTime(arg0 *big.Int) (*big.Int, error) {
+	return _LicenZNFT.Contract.TokenCreationTime(&_LicenZNFT.CallOpts, arg0)
+}
+
+// TokenCreationTime is a free data retrieval call binding the contract method 0x21e8b17e.
+//
+// Solidity: function tokenCreationTime(uint256 ) view returns(uint256)
+func (_LicenZNFT *LicenZNFTCallerSession) TokenCreationTime(arg0 *big.Int) (*big.Int, error) {
+	return _LicenZNFT.Contract.TokenCreationTime(&_LicenZNFT.CallOpts, arg0)
+}
+
+// TokenCreators is a free data retrieval call binding the contract method 0xe0fd045f.
+//
+// Solidity: function tokenCreators(uint256 ) view returns(address)
+func (_LicenZNFT *LicenZNFTCaller) TokenCreators(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "tokenCreators", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TokenCreators is a free data retrieval call binding the contract method 0xe0fd045f.
+//
+// Solidity: function tokenCreators(uint256 ) view returns(address)
+func (_LicenZNFT *LicenZNFTSession) TokenCreators(arg0 *big.Int) (common.Address, error) {
+	return _LicenZNFT.Contract.TokenCreators(&_LicenZNFT.CallOpts, arg0)
+}
+
+// TokenCreators is a free data retrieval call binding the contract method 0xe0fd045f.
+//
+// Solidity: function tokenCreators(uint256 ) view returns(address)
+func (_LicenZNFT *LicenZNFTCallerSession) TokenCreators(arg0 *big.Int) (common.Address, error) {
+	return _LicenZNFT.Contract.TokenCreators(&_LicenZNFT.CallOpts, arg0)
+}
+
+// TokenIdToContentHash is a free data retrieval call binding the contract method 0x1fd03893.
+//
+// Solidity: function tokenIdToContentHash(uint256 ) view returns(bytes32)
+func (_LicenZNFT *LicenZNFTCaller) TokenIdToContentHash(opts *bind.CallOpts, arg0 *big.Int) ([32]byte, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "tokenIdToContentHash", arg0)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// TokenIdToContentHash is a free data retrieval call binding the contract method 0x1fd03893.
+//
+// Solidity: function tokenIdToContentHash(uint256 ) view returns(bytes32)
+func (_LicenZNFT *LicenZNFTSession) TokenIdToContentHash(arg0 *big.Int) ([32]byte, error) {
+	return _LicenZNFT.Contract.TokenIdToContentHash(&_LicenZNFT.CallOpts, arg0)
+}
+
+// TokenIdToContentHash is a free data retrieval call binding the contract method 0x1fd03893.
+//
+// Solidity: function tokenIdToContentHash(uint256 ) view returns(bytes32)
+func (_LicenZNFT *LicenZNFTCallerSession) TokenIdToContentHash(arg0 *big.Int) ([32]byte, error) {
+	return _LicenZNFT.Contract.TokenIdToContentHash(&_LicenZNFT.CallOpts, arg0)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LicenZNFT *LicenZNFTCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LicenZNFT *LicenZNFTSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _LicenZNFT.Contract.TokenURI(&_LicenZNFT.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LicenZNFT *LicenZNFTCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _LicenZNFT.Contract.TokenURI(&_LicenZNFT.CallOpts, tokenId)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LicenZNFT *LicenZNFTCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LicenZNFT.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LicenZNFT *LicenZNFTSession) TotalSupply() (*big.Int, error) {
+	return _LicenZNFT.Contract.TotalSupply(&_LicenZNFT.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LicenZNFT *LicenZNFTCallerSession) TotalSupply() (*big.Int, error) {
+	return _LicenZNFT.Contract.TotalSupply(&_LicenZNFT.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LicenZNFT *LicenZNFTTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZNFT.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LicenZNFT *LicenZNFTSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.Approve(&_LicenZNFT.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LicenZNFT *LicenZNFTTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.Approve(&_LicenZNFT.TransactOpts, to, tokenId)
+}
+
+// MintNFT is a paid mutator transaction binding the contract method 0x84dae698.
+//
+// Solidity: function mintNFT(address to, bytes32 contentHash, string uri) returns(uint256)
+func (_LicenZNFT *LicenZNFTTransactor) MintNFT(opts *bind.TransactOpts, to common.Address, contentHash [32]byte, uri string) (*types.Transaction, error) {
+	return _LicenZNFT.contract.Transact(opts, "mintNFT", to, contentHash, uri)
+}
+
+// MintNFT is a paid mutator transaction binding the contract method 0x84dae698.
+//
+// Solidity: function mintNFT(address to, bytes32 contentHash, string uri) returns(uint256)
+func (_LicenZNFT *LicenZNFTSession) MintNFT(to common.Address, contentHash [32]byte, uri string) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.MintNFT(&_LicenZNFT.TransactOpts, to, contentHash, uri)
+}
+
+// MintNFT is a paid mutator transaction binding the contract method 0x84dae698.
+//
+// Solidity: function mintNFT(address to, bytes32 contentHash, string uri) returns(uint256)
+func (_LicenZNFT *LicenZNFTTransactorSession) MintNFT(to common.Address, contentHash [32]byte, uri string) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.MintNFT(&_LicenZNFT.TransactOpts, to, contentHash, uri)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LicenZNFT *LicenZNFTTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LicenZNFT.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LicenZNFT *LicenZNFTSession) RenounceOwnership() (*types.Transaction, error) {
+	return _LicenZNFT.Contract.RenounceOwnership(&_LicenZNFT.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LicenZNFT *LicenZNFTTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _LicenZNFT.Contract.RenounceOwnership(&_LicenZNFT.TransactOpts)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LicenZNFT *LicenZNFTTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZNFT.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LicenZNFT *LicenZNFTSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.SafeTransferFrom(&_LicenZNFT.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LicenZNFT *LicenZNFTTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.SafeTransferFrom(&_LicenZNFT.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LicenZNFT *LicenZNFTTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LicenZNFT.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LicenZNFT *LicenZNFTSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.SafeTransferFrom0(&_LicenZNFT.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LicenZNFT *LicenZNFTTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.SafeTransferFrom0(&_LicenZNFT.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LicenZNFT *LicenZNFTTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LicenZNFT.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LicenZNFT *LicenZNFTSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.SetApprovalForAll(&_LicenZNFT.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LicenZNFT *LicenZNFTTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.SetApprovalForAll(&_LicenZNFT.TransactOpts, operator, approved)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LicenZNFT *LicenZNFTTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZNFT.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LicenZNFT *LicenZNFTSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.TransferFrom(&_LicenZNFT.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LicenZNFT *LicenZNFTTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.TransferFrom(&_LicenZNFT.TransactOpts, from, to, tokenId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LicenZNFT *LicenZNFTTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _LicenZNFT.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LicenZNFT *LicenZNFTSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.TransferOwnership(&_LicenZNFT.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LicenZNFT *LicenZNFTTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LicenZNFT.Contract.TransferOwnership(&_LicenZNFT.TransactOpts, newOwner)
+}
+
+// LicenZNFTApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the LicenZNFT contract.
+type LicenZNFTApprovalIterator struct {
+	Event *LicenZNFTApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZNFTApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZNFTApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZNFTApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZNFTApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZNFTApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZNFTApproval represents a Approval event raised by the LicenZNFT contract.
+type LicenZNFTApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LicenZNFT *LicenZNFTFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*LicenZNFTApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LicenZNFT.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZNFTApprovalIterator{contract: _LicenZNFT.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LicenZNFT *LicenZNFTFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *LicenZNFTApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LicenZNFT.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZNFTApproval)
+				if err := _LicenZNFT.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LicenZNFT *LicenZNFTFilterer) ParseApproval(log types.Log) (*LicenZNFTApproval, error) {
+	event := new(LicenZNFTApproval)
+	if err := _LicenZNFT.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZNFTApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the LicenZNFT contract.
+type LicenZNFTApprovalForAllIterator struct {
+	Event *LicenZNFTApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZNFTApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZNFTApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZNFTApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZNFTApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZNFTApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZNFTApprovalForAll represents a ApprovalForAll event raised by the LicenZNFT contract.
+type LicenZNFTApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LicenZNFT *LicenZNFTFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*LicenZNFTApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LicenZNFT.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZNFTApprovalForAllIterator{contract: _LicenZNFT.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LicenZNFT *LicenZNFTFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *LicenZNFTApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LicenZNFT.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZNFTApprovalForAll)
+				if err := _LicenZNFT.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LicenZNFT *LicenZNFTFilterer) ParseApprovalForAll(log types.Log) (*LicenZNFTApprovalForAll, error) {
+	event := new(LicenZNFTApprovalForAll)
+	if err := _LicenZNFT.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZNFTBatchMetadataUpdateIterator is returned from FilterBatchMetadataUpdate and is used to iterate over the raw logs and unpacked data for BatchMetadataUpdate events raised by the LicenZNFT contract.
+type LicenZNFTBatchMetadataUpdateIterator struct {
+	Event *LicenZNFTBatchMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZNFTBatchMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZNFTBatchMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZNFTBatchMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZNFTBatchMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZNFTBatchMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZNFTBatchMetadataUpdate represents a BatchMetadataUpdate event raised by the LicenZNFT contract.
+type LicenZNFTBatchMetadataUpdate struct {
+	FromTokenId *big.Int
+	ToTokenId   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchMetadataUpdate is a free log retrieval operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_LicenZNFT *LicenZNFTFilterer) FilterBatchMetadataUpdate(opts *bind.FilterOpts) (*LicenZNFTBatchMetadataUpdateIterator, error) {
+
+	logs, sub, err := _LicenZNFT.contract.FilterLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZNFTBatchMetadataUpdateIterator{contract: _LicenZNFT.contract, event: "BatchMetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchMetadataUpdate is a free log subscription operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_LicenZNFT *LicenZNFTFilterer) WatchBatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *LicenZNFTBatchMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _LicenZNFT.contract.WatchLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZNFTBatchMetadataUpdate)
+				if err := _LicenZNFT.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchMetadataUpdate is a log parse operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_LicenZNFT *LicenZNFTFilterer) ParseBatchMetadataUpdate(log types.Log) (*LicenZNFTBatchMetadataUpdate, error) {
+	event := new(LicenZNFTBatchMetadataUpdate)
+	if err := _LicenZNFT.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZNFTContentHashUpdatedIterator is returned from FilterContentHashUpdated and is used to iterate over the raw logs and unpacked data for ContentHashUpdated events raised by the LicenZNFT contract.
+type LicenZNFTContentHashUpdatedIterator struct {
+	Event *LicenZNFTContentHashUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZNFTContentHashUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZNFTContentHashUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZNFTContentHashUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZNFTContentHashUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZNFTContentHashUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZNFTContentHashUpdated represents a ContentHashUpdated event raised by the LicenZNFT contract.
+type LicenZNFTContentHashUpdated struct {
+	TokenId *big.Int
+	OldHash [32]byte
+	NewHash [32]byte
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterContentHashUpdated is a free log retrieval operation binding the contract event 0x9208016696973b155be5b5a6e02eec379ec03ec22f21efa10f060e4460d52fed.
+//
+// Solidity: event ContentHashUpdated(uint256 indexed tokenId, bytes32 indexed oldHash, bytes32 indexed newHash)
+func (_LicenZNFT *LicenZNFTFilterer) FilterContentHashUpdated(opts *bind.FilterOpts, tokenId []*big.Int, oldHash [][32]byte, newHash [][32]byte) (*LicenZNFTContentHashUpdatedIterator, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+	var oldHashRule []interface{}
+	for _, oldHashItem := range oldHash {
+		oldHashRule = append(oldHashRule, oldHashItem)
+	}
+	var newHashRule []interface{}
+	for _, newHashItem := range newHash {
+		newHashRule = append(newHashRule, newHashItem)
+	}
+
+	logs, sub, err := _LicenZNFT.contract.FilterLogs(opts, "ContentHashUpdated", tokenIdRule, oldHashRule, newHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZNFTContentHashUpdatedIterator{contract: _LicenZNFT.contract, event: "ContentHashUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchContentHashUpdated is a free log subscription operation binding the contract event 0x9208016696973b155be5b5a6e02eec379ec03ec22f21efa10f060e4460d52fed.
+//
+// Solidity: event ContentHashUpdated(uint256 indexed tokenId, bytes32 indexed oldHash, bytes32 indexed newHash)
+func (_LicenZNFT *LicenZNFTFilterer) WatchContentHashUpdated(opts *bind.WatchOpts, sink chan<- *LicenZNFTContentHashUpdated, tokenId []*big.Int, oldHash [][32]byte, newHash [][32]byte) (event.Subscription, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+	var oldHashRule []interface{}
+	for _, oldHashItem := range oldHash {
+		oldHashRule = append(oldHashRule, oldHashItem)
+	}
+	var newHashRule []interface{}
+	for _, newHashItem := range newHash {
+		newHashRule = append(newHashRule, newHashItem)
+	}
+
+	logs, sub, err := _LicenZNFT.contract.WatchLogs(opts, "ContentHashUpdated", tokenIdRule, oldHashRule, newHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZNFTContentHashUpdated)
+				if err := _LicenZNFT.contract.UnpackLog(event, "ContentHashUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContentHashUpdated is a log parse operation binding the contract event 0x9208016696973b155be5b5a6e02eec379ec03ec22f21efa10f060e4460d52fed.
+//
+// Solidity: event ContentHashUpdated(uint256 indexed tokenId, bytes32 indexed oldHash, bytes32 indexed newHash)
+func (_LicenZNFT *LicenZNFTFilterer) ParseContentHashUpdated(log types.Log) (*LicenZNFTContentHashUpdated, error) {
+	event := new(LicenZNFTContentHashUpdated)
+	if err := _LicenZNFT.contract.UnpackLog(event, "ContentHashUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZNFTMetadataUpdateIterator is returned from FilterMetadataUpdate and is used to iterate over the raw logs and unpacked data for MetadataUpdate events raised by the LicenZNFT contract.
+type LicenZNFTMetadataUpdateIterator struct {
+	Event *LicenZNFTMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZNFTMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZNFTMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZNFTMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZNFTMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZNFTMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZNFTMetadataUpdate represents a MetadataUpdate event raised by the LicenZNFT contract.
+type LicenZNFTMetadataUpdate struct {
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMetadataUpdate is a free log retrieval operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_LicenZNFT *LicenZNFTFilterer) FilterMetadataUpdate(opts *bind.FilterOpts) (*LicenZNFTMetadataUpdateIterator, error) {
+
+	logs, sub, err := _LicenZNFT.contract.FilterLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZNFTMetadataUpdateIterator{contract: _LicenZNFT.contract, event: "MetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchMetadataUpdate is a free log subscription operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_LicenZNFT *LicenZNFTFilterer) WatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *LicenZNFTMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _LicenZNFT.contract.WatchLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZNFTMetadataUpdate)
+				if err := _LicenZNFT.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMetadataUpdate is a log parse operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_LicenZNFT *LicenZNFTFilterer) ParseMetadataUpdate(log types.Log) (*LicenZNFTMetadataUpdate, error) {
+	event := new(LicenZNFTMetadataUpdate)
+	if err := _LicenZNFT.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZNFTNFTMintedIterator is returned from FilterNFTMinted and is used to iterate over the raw logs and unpacked data for NFTMinted events raised by the LicenZNFT contract.
+type LicenZNFTNFTMintedIterator struct {
+	Event *LicenZNFTNFTMinted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZNFTNFTMintedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZNFTNFTMinted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZNFTNFTMinted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZNFTNFTMintedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZNFTNFTMintedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZNFTNFTMinted represents a NFTMinted event raised by the LicenZNFT contract.
+type LicenZNFTNFTMinted struct {
+	TokenId     *big.Int
+	Creator     common.Address
+	ContentHash [32]byte
+	TokenURI    string
+	Timestamp   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterNFTMinted is a free log retrieval operation binding the contract event 0xb31fb1fc3cb60c3a8079ab69b0d0a30f6c05b12de7220efadee241083b74a6a3.
+//
+// Solidity: event NFTMinted(uint256 indexed tokenId, address indexed creator, bytes32 indexed contentHash, string tokenURI, uint256 timestamp)
+func (_LicenZNFT *LicenZNFTFilterer) FilterNFTMinted(opts *bind.FilterOpts, tokenId []*big.Int, creator []common.Address, contentHash [][32]byte) (*LicenZNFTNFTMintedIterator, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+	var contentHashRule []interface{}
+	for _, contentHashItem := range contentHash {
+		contentHashRule = append(contentHashRule, contentHashItem)
+	}
+
+	logs, sub, err := _LicenZNFT.contract.FilterLogs(opts, "NFTMinted", tokenIdRule, creatorRule, contentHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZNFTNFTMintedIterator{contract: _LicenZNFT.contract, event: "NFTMinted", logs: logs, sub: sub}, nil
+}
+
+// WatchNFTMinted is a free log subscription operation binding the contract event 0xb31fb1fc3cb60c3a8079ab69b0d0a30f6c05b12de7220efadee241083b74a6a3.
+//
+// Solidity: event NFTMinted(uint256 indexed tokenId, address indexed creator, bytes32 indexed contentHash, string tokenURI, uint256 timestamp)
+func (_LicenZNFT *LicenZNFTFilterer) WatchNFTMinted(opts *bind.WatchOpts, sink chan<- *LicenZNFTNFTMinted, tokenId []*big.Int, creator []common.Address, contentHash [][32]byte) (event.Subscription, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+	var contentHashRule []interface{}
+	for _, contentHashItem := range contentHash {
+		contentHashRule = append(contentHashRule, contentHashItem)
+	}
+
+	logs, sub, err := _LicenZNFT.contract.WatchLogs(opts, "NFTMinted", tokenIdRule, creatorRule, contentHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZNFTNFTMinted)
+				if err := _LicenZNFT.contract.UnpackLog(event, "NFTMinted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNFTMinted is a log parse operation binding the contract event 0xb31fb1fc3cb60c3a8079ab69b0d0a30f6c05b12de7220efadee241083b74a6a3.
+//
+// Solidity: event NFTMinted(uint256 indexed tokenId, address indexed creator, bytes32 indexed contentHash, string tokenURI, uint256 timestamp)
+func (_LicenZNFT *LicenZNFTFilterer) ParseNFTMinted(log types.Log) (*LicenZNFTNFTMinted, error) {
+	event := new(LicenZNFTNFTMinted)
+	if err := _LicenZNFT.contract.UnpackLog(event, "NFTMinted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZNFTOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the LicenZNFT contract.
+type LicenZNFTOwnershipTransferredIterator struct {
+	Event *LicenZNFTOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZNFTOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZNFTOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZNFTOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZNFTOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZNFTOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZNFTOwnershipTransferred represents a OwnershipTransferred event raised by the LicenZNFT contract.
+type LicenZNFTOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LicenZNFT *LicenZNFTFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*LicenZNFTOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LicenZNFT.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZNFTOwnershipTransferredIterator{contract: _LicenZNFT.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LicenZNFT *LicenZNFTFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *LicenZNFTOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LicenZNFT.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZNFTOwnershipTransferred)
+				if err := _LicenZNFT.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LicenZNFT *LicenZNFTFilterer) ParseOwnershipTransferred(log types.Log) (*LicenZNFTOwnershipTransferred, error) {
+	event := new(LicenZNFTOwnershipTransferred)
+	if err := _LicenZNFT.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LicenZNFTTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the LicenZNFT contract.
+type LicenZNFTTransferIterator struct {
+	Event *LicenZNFTTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LicenZNFTTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LicenZNFTTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LicenZNFTTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LicenZNFTTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LicenZNFTTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LicenZNFTTransfer represents a Transfer event raised by the LicenZNFT contract.
+type LicenZNFTTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LicenZNFT *LicenZNFTFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*LicenZNFTTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LicenZNFT.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LicenZNFTTransferIterator{contract: _LicenZNFT.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LicenZNFT *LicenZNFTFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *LicenZNFTTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LicenZNFT.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LicenZNFTTransfer)
+				if err := _LicenZNFT.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LicenZNFT *LicenZNFTFilterer) ParseTransfer(log types.Log) (*LicenZNFTTransfer, error) {
+	event := new(LicenZNFTTransfer)
+	if err := _LicenZNFT.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,151 @@
+package database
+
+import (
+	"strings"
+	"time"
+
+	"licenz-backend/models"
+)
+
+// ContentFilter narrows a listing. Zero-valued fields do not filter, and
+// all set fields must match.
+type ContentFilter struct {
+	UserID      string
+	ContentHash string   // exact, ignoring case
+	Styles      []string // any of
+	Models      []string // any of
+
+	IsLicensed *bool
+	NFTMinted  *bool
+
+	CreatedAfter  time.Time // inclusive
+	CreatedBefore time.Time // exclusive
+
+	MinWidth  int
+	MaxWidth  int
+	MinHeight int
+	MaxHeight int
+
+	ExcludeHidden bool // drop content hidden by a moderator
+}
+
+// Matches reports whether content passes every filter
+func (f ContentFilter) Matches(c *models.Content) bool {
+	if f.UserID != "" && c.UserID != f.UserID {
+		return false
+	}
+	if f.ContentHash != "" && !strings.EqualFold(c.ContentHash, f.ContentHash) {
+		return false
+	}
+	if len(f.Styles) > 0 && !containsString(f.Styles, c.Style) {
+		return false
+	}
+	if len(f.Models) > 0 && !containsString(f.Models, c.Model) {
+		return false
+	}
+	if f.IsLicensed != nil && c.IsLicensed != *f.IsLicensed {
+		return false
+	}
+	if f.NFTMinted != nil && c.NFTMinted != *f.NFTMinted {
+		return false
+	}
+	if !f.CreatedAfter.IsZero() && c.CreatedAt.Before(f.CreatedAfter) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && !c.CreatedAt.Before(f.CreatedBefore) {
+		return false
+	}
+	if f.MinWidth > 0 && c.Width < f.MinWidth {
+		return false
+	}
+	if f.MaxWidth > 0 && c.Width > f.MaxWidth {
+		return false
+	}
+	if f.MinHeight > 0 && c.Height < f.MinHeight {
+		return false
+	}
+	if f.MaxHeight > 0 && c.Height > f.MaxHeight {
+		return false
+	}
+	if f.ExcludeHidden && c.Hidden {
+		return false
+	}
+	return true
+}
+
+// sqlConditions translates the filter into SQLite WHERE conditions
+func (f ContentFilter) sqlConditions() ([]string, []interface{}) {
+	var conds []string
+	var args []interface{}
+
+	if f.UserID != "" {
+		conds = append(conds, "user_id = ?")
+		args = append(args, f.UserID)
+	}
+	if f.ContentHash != "" {
+		conds = append(conds, "content_hash = ? COLLATE NOCASE")
+		args = append(args, f.ContentHash)
+	}
+	if len(f.Styles) > 0 {
+		conds = append(conds, "style IN ("+placeholders(len(f.Styles))+")")
+		for _, s := range f.Styles {
+			args = append(args, s)
+		}
+	}
+	if len(f.Models) > 0 {
+		conds = append(conds, "model IN ("+placeholders(len(f.Models))+")")
+		for _, m := range f.Models {
+			args = append(args, m)
+		}
+	}
+	if f.IsLicensed != nil {
+		conds = append(conds, "is_licensed = ?")
+		args = append(args, *f.IsLicensed)
+	}
+	if f.NFTMinted != nil {
+		conds = append(conds, "nft_minted = ?")
+		args = append(args, *f.NFTMinted)
+	}
+	if !f.CreatedAfter.IsZero() {
+		conds = append(conds, "created_at >= ?")
+		args = append(args, f.CreatedAfter.UnixNano())
+	}
+	if !f.CreatedBefore.IsZero() {
+		conds = append(conds, "COALESCE(created_at, 0) < ?")
+		args = append(args, f.CreatedBefore.UnixNano())
+	}
+	for _, bound := range []struct {
+		cond  string
+		value int
+	}{
+		{"width >= ?", f.MinWidth},
+		{"width <= ?", f.MaxWidth},
+		{"height >= ?", f.MinHeight},
+		{"height <= ?", f.MaxHeight},
+	} {
+		if bound.value > 0 {
+			conds = append(conds, bound.cond)
+			args = append(args, bound.value)
+		}
+	}
+	if f.ExcludeHidden {
+		conds = append(conds, "hidden = 0")
+	}
+
+	return conds, args
+}
+
+// placeholders returns n comma-separated SQL parameters
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package database
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"licenz-backend/models"
+)
+
+var filterBase = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// filterFixture covers each filtered attribute with matching and
+// non-matching values
+var filterFixture = []models.Content{
+	{ID: "a", UserID: "alice", Style: "oil", Model: "sdxl", ContentHash: "0xAB12", CreatedAt: filterBase, Width: 512, Height: 512, IsLicensed: true, LicenseType: "commercial"},
+	{ID: "b", UserID: "alice", Style: "ink", Model: "sd15", ContentHash: "0xcd34", CreatedAt: filterBase.Add(time.Hour), Width: 1024, Height: 768, NFTMinted: true, NFTTokenID: "1"},
+	{ID: "c", UserID: "bob", Style: "oil", Model: "sd15", ContentHash: "0xef56", CreatedAt: filterBase.Add(2 * time.Hour), Width: 2048, Height: 2048, IsLicensed: true, LicenseType: "editorial", NFTMinted: true, NFTTokenID: "2"},
+	{ID: "d", UserID: "bob", Style: "watercolor", Model: "sdxl", CreatedAt: filterBase.Add(-time.Hour), Width: 256, Height: 1024, Hidden: true},
+	{ID: "e", UserID: "carol", Style: "ink", Model: "dalle"},
+}
+
+func boolPtr(b bool) *bool { return &b }
+
+func createFilterFixture(t *testing.T, store ContentStore) {
+	t.Helper()
+	for _, content := range filterFixture {
+		if err := store.CreateContent(content); err != nil {
+			t.Fatalf("CreateContent: %v", err)
+		}
+	}
+}
+
+// filteredIDs lists the IDs GetAllContent returns for filter, sorted
+func filteredIDs(t *testing.T, store ContentStore, filter ContentFilter) []string {
+	t.Helper()
+	result, err := store.GetAllContent(ListOptions{Filter: filter})
+	if err != nil {
+		t.Fatalf("GetAllContent(%+v): %v", filter, err)
+	}
+	got := ids(result.Items)
+	sort.Strings(got)
+	if result.Total != len(got) {
+		t.Errorf("Total = %d for %d items", result.Total, len(got))
+	}
+	return got
+}
+
+func TestContentFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter ContentFilter
+		want   []string
+	}{
+		{"none", ContentFilter{}, []string{"a", "b", "c", "d", "e"}},
+		{"user", ContentFilter{UserID: "alice"}, []string{"a", "b"}},
+		{"unknown user", ContentFilter{UserID: "mallory"}, []string{}},
+		{"hash ignores case", ContentFilter{ContentHash: "0xab12"}, []string{"a"}},
+		{"one style", ContentFilter{Styles: []string{"ink"}}, []string{"b", "e"}},
+		{"any of styles", ContentFilter{Styles: []string{"oil", "watercolor"}}, []string{"a", "c", "d"}},
+		{"any of models", ContentFilter{Models: []string{"sd15", "dalle"}}, []string{"b", "c", "e"}},
+		{"licensed", ContentFilter{IsLicensed: boolPtr(true)}, []string{"a", "c"}},
+		{"unlicensed", ContentFilter{IsLicensed: boolPtr(false)}, []string{"b", "d", "e"}},
+		{"minted", ContentFilter{NFTMinted: boolPtr(true)}, []string{"b", "c"}},
+		{"unminted", ContentFilter{NFTMinted: boolPtr(false)}, []string{"a", "d", "e"}},
+		{"created after is inclusive", ContentFilter{CreatedAfter: filterBase.Add(time.Hour)}, []string{"b", "c"}},
+		{"created before is exclusive", ContentFilter{CreatedBefore: filterBase.Add(time.Hour)}, []string{"a", "d", "e"}},
+		{"created window", ContentFilter{CreatedAfter: filterBase, CreatedBefore: filterBase.Add(2 * time.Hour)}, []string{"a", "b"}},
+		{"min width", ContentFilter{MinWidth: 1024}, []string{"b", "c"}},
+		{"max width", ContentFilter{MaxWidth: 512}, []string{"a", "d", "e"}},
+		{"min height", ContentFilter{MinHeight: 1024}, []string{"c", "d"}},
+		{"max height", ContentFilter{MaxHeight: 768}, []string{"a", "b", "e"}},
+		{"exclude hidden", ContentFilter{ExcludeHidden: true}, []string{"a", "b", "c", "e"}},
+		{"all must match", ContentFilter{UserID: "bob", Styles: []string{"oil", "watercolor"}, ExcludeHidden: true}, []string{"c"}},
+		{"contradiction", ContentFilter{UserID: "alice", MinWidth: 4096}, []string{}},
+	}
+
+	for _, test := range tests {
+		var want []string
+		for i := range filterFixture {
+			if test.filter.Matches(&filterFixture[i]) {
+				want = append(want, filterFixture[i].ID)
+			}
+		}
+		if fmt.Sprint(want) != fmt.Sprint(test.want) {
+			t.Errorf("%s: Matches selects %v, want %v", test.name, want, test.want)
+		}
+	}
+
+	// Every backend, including the SQL translation, agrees with Matches
+	forEachStore(t, func(t *testing.T, store ContentStore) {
+		createFilterFixture(t, store)
+		for _, test := range tests {
+			if got := filteredIDs(t, store, test.filter); fmt.Sprint(got) != fmt.Sprint(test.want) {
+				t.Errorf("%s: GetAllContent returns %v, want %v", test.name, got, test.want)
+			}
+		}
+	})
+}
+
+func TestListFacetsCountEveryFilteredItem(t *testing.T) {
+	forEachStore(t, func(t *testing.T, store ContentStore) {
+		createFilterFixture(t, store)
+
+		result, err := store.GetAllContent(ListOptions{
+			Limit:  1,
+			Facets: true,
+			Filter: ContentFilter{ExcludeHidden: true},
+		})
+		if err != nil {
+			t.Fatalf("GetAllContent: %v", err)
+		}
+		if len(result.Items) != 1 || result.Total != 4 {
+			t.Fatalf("page has %d of %d items, want 1 of 4", len(result.Items), result.Total)
+		}
+
+		// d is hidden, so its watercolor style and bob's second item are not counted
+		want := &models.Facets{
+			Style:       map[string]int{"oil": 2, "ink": 2},
+			Model:       map[string]int{"sdxl": 1, "sd15": 2, "dalle": 1},
+			IsLicensed:  map[string]int{"true": 2, "false": 2},
+			NFTMinted:   map[string]int{"true": 2, "false": 2},
+			LicenseType: map[string]int{"commercial": 1, "editorial": 1},
+			Creator:     map[string]int{"alice": 2, "bob": 1, "carol": 1},
+		}
+		if !reflect.DeepEqual(result.Facets, want) {
+			t.Errorf("facets = %+v, want %+v", result.Facets, want)
+		}
+
+		if unrequested, _ := store.GetAllContent(ListOptions{Limit: 1}); unrequested.Facets != nil {
+			t.Errorf("facets = %+v without asking", unrequested.Facets)
+		}
+	})
+}
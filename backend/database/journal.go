@@ -0,0 +1,242 @@
+package database
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"licenz-backend/fsutil"
+	"licenz-backend/models"
+)
+
+// compactEvery is how many journal entries accumulate before the JSON
+// stores fold them into a fresh snapshot
+const compactEvery = 500
+
+// Journal operations
+const (
+	opPut    = "put"
+	opDelete = "delete"
+)
+
+// journalOp is one line of the append-only operation log
+type journalOp struct {
+	Op      string          `json:"op"`
+	ID      string          `json:"id"`
+	Content *models.Content `json:"content,omitempty"`
+}
+
+// apply replays the operation against an in-memory content map
+func (op journalOp) apply(content map[string]models.Content) {
+	switch op.Op {
+	case opPut:
+		if op.Content != nil {
+			content[op.ID] = *op.Content
+		}
+	case opDelete:
+		delete(content, op.ID)
+	}
+}
+
+// jsonFile persists a content map as a JSON snapshot (content.json) plus an
+// append-only journal of operations since that snapshot (content.json.wal).
+// Every mutation is fsynced to the journal before it is acknowledged, and
+// the snapshot is only ever replaced by atomic rename, so an interrupted
+// write can lose at most the operation in flight — never the catalog.
+type jsonFile struct {
+	snapshotPath string
+	journalPath  string
+	journal      journalFile
+	entries      int
+}
+
+// journalFile is the open journal, an *os.File outside of tests
+type journalFile interface {
+	io.WriteSeeker
+	Sync() error
+	Truncate(size int64) error
+	Close() error
+}
+
+// openJSONFile loads the snapshot at path, replays its journal on top and
+// returns the recovered content. A snapshot that exists but cannot be parsed
+// is an error: starting empty would silently discard the catalog.
+func openJSONFile(path string) (*jsonFile, map[string]models.Content, error) {
+	// Ensure data directory exists
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, nil, fmt.Errorf("failed to create data directory: %v", err)
+	}
+
+	f := &jsonFile{snapshotPath: path, journalPath: path + ".wal"}
+	content := make(map[string]models.Content)
+
+	data, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		fmt.Printf("📁 No existing database found, starting fresh\n")
+	case err != nil:
+		return nil, nil, fmt.Errorf("failed to read %s: %v", path, err)
+	default:
+		var contentList []models.Content
+		if err := json.Unmarshal(data, &contentList); err != nil {
+			return nil, nil, fmt.Errorf("database snapshot %s is corrupt, refusing to start empty: %v", path, err)
+		}
+		for _, c := range contentList {
+			content[c.ID] = c
+		}
+	}
+
+	replayed, err := f.replay(content)
+	if err != nil {
+		return nil, nil, err
+	}
+	if replayed > 0 {
+		fmt.Printf("🔁 Replayed %d journal entries from %s\n", replayed, f.journalPath)
+	}
+
+	f.journal, err = os.OpenFile(f.journalPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open journal: %v", err)
+	}
+	f.entries = replayed
+
+	return f, content, nil
+}
+
+// replay applies every complete journal entry to content. A torn final line
+// (the write that was in flight when the process died) is cut off so new
+// entries are not appended onto it.
+func (f *jsonFile) replay(content map[string]models.Content) (int, error) {
+	file, err := os.Open(f.journalPath)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to open journal: %v", err)
+	}
+	defer file.Close()
+
+	replayed := 0
+	var offset int64
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			if len(line) > 0 {
+				fmt.Printf("⚠️ Warning: discarding incomplete journal entry\n")
+				if err := os.Truncate(f.journalPath, offset); err != nil {
+					return replayed, fmt.Errorf("failed to truncate journal: %v", err)
+				}
+			}
+			break
+		}
+		if err != nil {
+			return replayed, fmt.Errorf("failed to read journal: %v", err)
+		}
+
+		var op journalOp
+		if err := json.Unmarshal(line, &op); err != nil {
+			return replayed, fmt.Errorf("journal %s is corrupt at entry %d: %v", f.journalPath, replayed+1, err)
+		}
+		op.apply(content)
+		replayed++
+		offset += int64(len(line))
+	}
+
+	return replayed, nil
+}
+
+// record durably appends operations to the journal
+func (f *jsonFile) record(ops ...journalOp) error {
+	var buf bytes.Buffer
+	for _, op := range ops {
+		line, err := json.Marshal(op)
+		if err != nil {
+			return fmt.Errorf("failed to marshal journal entry: %v", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	// Remember where the journal ends so a failed append can be cut off;
+	// otherwise a partial line would be glued to the next entry, and an
+	// unsynced one could be replayed after the caller was told it failed
+	offset, err := f.journal.Seek(0, io.SeekEnd)
+	if err != nil {
+		return fmt.Errorf("failed to seek journal: %v", err)
+	}
+	if err := f.append(buf.Bytes()); err != nil {
+		if terr := f.journal.Truncate(offset); terr != nil {
+			return fmt.Errorf("%v (and failed to roll back journal: %v)", err, terr)
+		}
+		return err
+	}
+
+	f.entries += len(ops)
+	return nil
+}
+
+// append writes and fsyncs data at the end of the journal
+func (f *jsonFile) append(data []byte) error {
+	if _, err := f.journal.Write(data); err != nil {
+		return fmt.Errorf("failed to write journal: %v", err)
+	}
+	if err := f.journal.Sync(); err != nil {
+		return fmt.Errorf("failed to sync journal: %v", err)
+	}
+	return nil
+}
+
+// needsCompaction reports whether the journal has grown past compactEvery
+func (f *jsonFile) needsCompaction() bool {
+	return f.entries >= compactEvery
+}
+
+// compact writes content as the new snapshot and truncates the journal.
+// If the process dies between the two steps the journal is simply replayed
+// over a snapshot that already contains it, which is harmless.
+func (f *jsonFile) compact(content map[string]models.Content) error {
+	if err := writeSnapshot(f.snapshotPath, content); err != nil {
+		return err
+	}
+
+	if err := f.journal.Truncate(0); err != nil {
+		return fmt.Errorf("failed to truncate journal: %v", err)
+	}
+	if err := f.journal.Sync(); err != nil {
+		return fmt.Errorf("failed to sync journal: %v", err)
+	}
+
+	f.entries = 0
+	return nil
+}
+
+// close compacts and releases the journal
+func (f *jsonFile) close(content map[string]models.Content) error {
+	if err := f.compact(content); err != nil {
+		return err
+	}
+	return f.journal.Close()
+}
+
+// writeSnapshot atomically replaces path with content as a JSON array,
+// ordered by ID so snapshots diff cleanly
+func writeSnapshot(path string, content map[string]models.Content) error {
+	contentList := make([]models.Content, 0, len(content))
+	for _, c := range content {
+		contentList = append(contentList, c)
+	}
+	sort.Slice(contentList, func(i, j int) bool { return contentList[i].ID < contentList[j].ID })
+
+	data, err := json.MarshalIndent(contentList, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal content: %v", err)
+	}
+
+	return fsutil.WriteFileAtomic(path, data, 0644)
+}
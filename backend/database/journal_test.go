@@ -0,0 +1,174 @@
+package database
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"licenz-backend/models"
+)
+
+// openTestJournal opens the jsonFile at path, closing it after the test
+func openTestJournal(t *testing.T, path string) (*jsonFile, map[string]models.Content) {
+	t.Helper()
+	f, content, err := openJSONFile(path)
+	if err != nil {
+		t.Fatalf("openJSONFile: %v", err)
+	}
+	t.Cleanup(func() { f.journal.Close() })
+	return f, content
+}
+
+func putOp(id, prompt string) journalOp {
+	return journalOp{Op: opPut, ID: id, Content: &models.Content{ID: id, Prompt: prompt}}
+}
+
+// wantPrompts checks content holds exactly the given ID → prompt pairs
+func wantPrompts(t *testing.T, content map[string]models.Content, want map[string]string) {
+	t.Helper()
+	if len(content) != len(want) {
+		t.Fatalf("content has %d records, want %d: %v", len(content), len(want), content)
+	}
+	for id, prompt := range want {
+		if content[id].Prompt != prompt {
+			t.Errorf("content[%s].Prompt = %q, want %q", id, content[id].Prompt, prompt)
+		}
+	}
+}
+
+func TestJournalReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "content.json")
+	f, _ := openTestJournal(t, path)
+
+	ops := []journalOp{putOp("a", "first"), putOp("b", "second"), putOp("a", "updated"), {Op: opDelete, ID: "b"}}
+	for _, op := range ops {
+		if err := f.record(op); err != nil {
+			t.Fatalf("record: %v", err)
+		}
+	}
+	// Crash without compacting: only the journal holds the writes
+	f.journal.Close()
+
+	reopened, content := openTestJournal(t, path)
+	wantPrompts(t, content, map[string]string{"a": "updated"})
+	if reopened.entries != len(ops) {
+		t.Errorf("entries = %d, want %d", reopened.entries, len(ops))
+	}
+}
+
+func TestJournalTruncatesTornTail(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "content.json")
+	f, _ := openTestJournal(t, path)
+	if err := f.record(putOp("a", "kept")); err != nil {
+		t.Fatalf("record: %v", err)
+	}
+	f.journal.Close()
+
+	stat, _ := os.Stat(path + ".wal")
+	complete := stat.Size()
+	journal, _ := os.OpenFile(path+".wal", os.O_WRONLY|os.O_APPEND, 0644)
+	journal.WriteString(`{"op":"put","id":"b","content":{"id":"b","pro`)
+	journal.Close()
+
+	f, content := openTestJournal(t, path)
+	wantPrompts(t, content, map[string]string{"a": "kept"})
+	if stat, _ := os.Stat(path + ".wal"); stat.Size() != complete {
+		t.Fatalf("journal is %d bytes after recovery, want %d", stat.Size(), complete)
+	}
+
+	// New entries start on a fresh line
+	if err := f.record(putOp("c", "after")); err != nil {
+		t.Fatalf("record: %v", err)
+	}
+	f.journal.Close()
+	_, content = openTestJournal(t, path)
+	wantPrompts(t, content, map[string]string{"a": "kept", "c": "after"})
+}
+
+func TestJournalCompaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "content.json")
+	f, content := openTestJournal(t, path)
+
+	for _, op := range []journalOp{putOp("a", "one"), putOp("b", "two")} {
+		if err := f.record(op); err != nil {
+			t.Fatalf("record: %v", err)
+		}
+		op.apply(content)
+	}
+	if err := f.compact(content); err != nil {
+		t.Fatalf("compact: %v", err)
+	}
+	if f.entries != 0 || f.needsCompaction() {
+		t.Errorf("entries = %d after compaction", f.entries)
+	}
+	if stat, _ := os.Stat(path + ".wal"); stat.Size() != 0 {
+		t.Errorf("journal is %d bytes after compaction, want 0", stat.Size())
+	}
+
+	// Writes after compaction land on top of the snapshot
+	if err := f.record(journalOp{Op: opDelete, ID: "a"}); err != nil {
+		t.Fatalf("record: %v", err)
+	}
+	f.journal.Close()
+
+	reopened, content := openTestJournal(t, path)
+	wantPrompts(t, content, map[string]string{"b": "two"})
+	if reopened.entries != 1 {
+		t.Errorf("entries = %d, want the 1 written after compaction", reopened.entries)
+	}
+}
+
+// faultyJournal fails writes after writing half the data, or fails syncs
+type faultyJournal struct {
+	*os.File
+	failWrite, failSync bool
+}
+
+func (j *faultyJournal) Write(p []byte) (int, error) {
+	if j.failWrite {
+		n, _ := j.File.Write(p[:len(p)/2])
+		return n, errors.New("disk full")
+	}
+	return j.File.Write(p)
+}
+
+func (j *faultyJournal) Sync() error {
+	if j.failSync {
+		return errors.New("i/o error")
+	}
+	return j.File.Sync()
+}
+
+func TestJournalRollsBackFailedWrites(t *testing.T) {
+	for _, fault := range []faultyJournal{{failWrite: true}, {failSync: true}} {
+		path := filepath.Join(t.TempDir(), "content.json")
+		f, _ := openTestJournal(t, path)
+		if err := f.record(putOp("a", "kept")); err != nil {
+			t.Fatalf("record: %v", err)
+		}
+		stat, _ := os.Stat(path + ".wal")
+		before := stat.Size()
+
+		fault.File = f.journal.(*os.File)
+		f.journal = &fault
+		if err := f.record(putOp("b", "lost")); err == nil {
+			t.Fatalf("record with %+v succeeded", fault)
+		}
+		if stat, _ := os.Stat(path + ".wal"); stat.Size() != before {
+			t.Fatalf("journal is %d bytes after a failed write, want %d", stat.Size(), before)
+		}
+		if f.entries != 1 {
+			t.Errorf("entries = %d, want the failed write uncounted", f.entries)
+		}
+
+		// The journal stays usable and the failed entry is never replayed
+		f.journal = fault.File
+		if err := f.record(putOp("c", "after")); err != nil {
+			t.Fatalf("record after failure: %v", err)
+		}
+		f.journal.Close()
+		_, content := openTestJournal(t, path)
+		wantPrompts(t, content, map[string]string{"a": "kept", "c": "after"})
+	}
+}
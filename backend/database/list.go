@@ -0,0 +1,224 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"licenz-backend/models"
+)
+
+// DefaultSort orders listings newest first
+const DefaultSort = "-created_at"
+
+// Errors returned for malformed listing requests
+var (
+	ErrInvalidSort   = errors.New("invalid sort")
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// ListOptions controls GetAllContent. Results are always ordered by Sort
+// with the content ID as a tiebreak, so pages are stable and repeatable.
+type ListOptions struct {
+	Limit  int    // page size; 0 returns every remaining item
+	Offset int    // legacy paging; ignored when Cursor is set
+	Cursor string // opaque token from a previous ListResult.NextCursor
+	Sort   string // field name, prefixed with "-" for descending; defaults to DefaultSort
+	Filter ContentFilter
+	Facets bool // also count every filtered item per facet value
+}
+
+// ListResult is one page of content
+type ListResult struct {
+	Items      []models.Content
+	Total      int            // number of items matching the query, across all pages
+	NextCursor string         // empty on the last page
+	Facets     *models.Facets // set when ListOptions.Facets was requested
+}
+
+// sortField describes a sortable content attribute. Numeric fields compare
+// by num, text fields by str.
+type sortField struct {
+	column  string // SQLite expression
+	numeric bool
+	num     func(c *models.Content) int64
+	str     func(c *models.Content) string
+}
+
+// sortFields lists the attributes accepted by ?sort=
+var sortFields = map[string]sortField{
+	"created_at": {column: "COALESCE(created_at, 0)", numeric: true, num: func(c *models.Content) int64 { return sortTime(c.CreatedAt) }},
+	"updated_at": {column: "COALESCE(updated_at, 0)", numeric: true, num: func(c *models.Content) int64 { return sortTime(c.UpdatedAt) }},
+	"style":      {column: "style", str: func(c *models.Content) string { return c.Style }},
+	"model":      {column: "model", str: func(c *models.Content) string { return c.Model }},
+}
+
+// sortTime maps zero times to 0 so they sort before every real timestamp
+func sortTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+// sortSpec is a parsed Sort option
+type sortSpec struct {
+	name  string // as given, e.g. "-created_at"
+	field sortField
+	desc  bool
+}
+
+// parseSort resolves a sort option, applying the default
+func parseSort(s string) (sortSpec, error) {
+	if s == "" {
+		s = DefaultSort
+	}
+	name := strings.TrimPrefix(s, "-")
+	field, ok := sortFields[name]
+	if !ok {
+		return sortSpec{}, fmt.Errorf("%w: unknown field %q", ErrInvalidSort, name)
+	}
+	return sortSpec{name: s, field: field, desc: strings.HasPrefix(s, "-")}, nil
+}
+
+// cursor is the decoded form of a NextCursor token: the sort it belongs to
+// and the sort key and ID of the last item on the previous page
+type cursor struct {
+	Sort string `json:"s"`
+	Num  int64  `json:"n,omitempty"`
+	Str  string `json:"v,omitempty"`
+	ID   string `json:"id"`
+}
+
+// encode serializes the cursor as an opaque URL-safe token
+func (c cursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor parses a token and checks it was issued for the same sort
+func decodeCursor(token string, spec sortSpec) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+	if c.Sort != spec.name {
+		return nil, fmt.Errorf("%w: cursor was issued for sort %q", ErrInvalidCursor, c.Sort)
+	}
+	return &c, nil
+}
+
+// cursorAfter builds the cursor pointing just past content
+func (s sortSpec) cursorAfter(content *models.Content) string {
+	c := cursor{Sort: s.name, ID: content.ID}
+	if s.field.numeric {
+		c.Num = s.field.num(content)
+	} else {
+		c.Str = s.field.str(content)
+	}
+	return c.encode()
+}
+
+// compareKey orders content against a cursor position in ascending order
+func (s sortSpec) compareKey(content *models.Content, num int64, str, id string) int {
+	if s.field.numeric {
+		if v := s.field.num(content); v != num {
+			if v < num {
+				return -1
+			}
+			return 1
+		}
+	} else if v := s.field.str(content); v != str {
+		return strings.Compare(v, str)
+	}
+	return strings.Compare(content.ID, id)
+}
+
+// less reports whether a sorts before b under this spec
+func (s sortSpec) less(a, b *models.Content) bool {
+	var cmp int
+	if s.field.numeric {
+		cmp = s.compareKey(a, s.field.num(b), "", b.ID)
+	} else {
+		cmp = s.compareKey(a, 0, s.field.str(b), b.ID)
+	}
+	if s.desc {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+// after reports whether content sorts strictly after the cursor position
+func (s sortSpec) after(content *models.Content, c *cursor) bool {
+	cmp := s.compareKey(content, c.Num, c.Str, c.ID)
+	if s.desc {
+		return cmp < 0
+	}
+	return cmp > 0
+}
+
+// Validate checks the sort and cursor without running the query, so
+// handlers can report malformed requests as client errors
+func (o ListOptions) Validate() error {
+	spec, err := parseSort(o.Sort)
+	if err != nil {
+		return err
+	}
+	if o.Cursor != "" {
+		if _, err := decodeCursor(o.Cursor, spec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listContent sorts and pages an in-memory candidate set. It is shared by
+// the map-backed stores; candidates must already be filtered.
+func listContent(candidates []models.Content, opts ListOptions) (ListResult, error) {
+	spec, err := parseSort(opts.Sort)
+	if err != nil {
+		return ListResult{}, err
+	}
+
+	sort.Slice(candidates, func(i, j int) bool { return spec.less(&candidates[i], &candidates[j]) })
+	total := len(candidates)
+
+	start := 0
+	if opts.Cursor != "" {
+		c, err := decodeCursor(opts.Cursor, spec)
+		if err != nil {
+			return ListResult{}, err
+		}
+		start = sort.Search(total, func(i int) bool { return spec.after(&candidates[i], c) })
+	} else if opts.Offset > 0 {
+		start = opts.Offset
+	}
+	if start > total {
+		start = total
+	}
+
+	end := total
+	if opts.Limit > 0 && start+opts.Limit < total {
+		end = start + opts.Limit
+	}
+
+	result := ListResult{Items: candidates[start:end], Total: total}
+	if opts.Facets {
+		result.Facets = models.NewFacets()
+		for i := range candidates {
+			result.Facets.Add(&candidates[i])
+		}
+	}
+	if end < total && end > start {
+		result.NextCursor = spec.cursorAfter(&candidates[end-1])
+	}
+	return result, nil
+}
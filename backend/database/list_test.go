@@ -0,0 +1,140 @@
+package database
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"licenz-backend/models"
+)
+
+// createListFixture stores 11 items whose sort keys collide: three creation
+// times, two styles and one model, so only the ID tiebreak orders them
+func createListFixture(t *testing.T, store ContentStore) {
+	t.Helper()
+	base := time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+	for i := 0; i < 11; i++ {
+		content := models.Content{
+			ID:        fmt.Sprintf("id-%02d", (i*7)%11), // inserted out of ID order
+			Style:     []string{"oil", "ink"}[i%2],
+			Model:     "sdxl",
+			CreatedAt: base.Add(time.Duration(i%3) * time.Hour),
+			IsPublic:  true,
+		}
+		if err := store.CreateContent(content); err != nil {
+			t.Fatalf("CreateContent: %v", err)
+		}
+	}
+}
+
+// ids lists the IDs of items
+func ids(items []models.Content) []string {
+	out := make([]string, len(items))
+	for i, item := range items {
+		out[i] = item.ID
+	}
+	return out
+}
+
+func TestListCursorPagesWithoutSkipsOrRepeats(t *testing.T) {
+	forEachStore(t, func(t *testing.T, store ContentStore) {
+		createListFixture(t, store)
+
+		for _, sort := range []string{"", "created_at", "-created_at", "style", "-style", "model", "-updated_at"} {
+			all, err := store.GetAllContent(ListOptions{Sort: sort})
+			if err != nil {
+				t.Fatalf("GetAllContent(%q): %v", sort, err)
+			}
+			want := ids(all.Items)
+			if len(want) != 11 || all.NextCursor != "" {
+				t.Fatalf("sort %q: unpaged listing has %d items and cursor %q", sort, len(want), all.NextCursor)
+			}
+
+			var got []string
+			seen := make(map[string]bool)
+			opts := ListOptions{Sort: sort, Limit: 3}
+			for pages := 0; ; pages++ {
+				if pages > 4 {
+					t.Fatalf("sort %q: paging did not end", sort)
+				}
+				page, err := store.GetAllContent(opts)
+				if err != nil {
+					t.Fatalf("sort %q page %d: %v", sort, pages, err)
+				}
+				if page.Total != 11 {
+					t.Errorf("sort %q page %d: Total = %d, want 11", sort, pages, page.Total)
+				}
+				for _, id := range ids(page.Items) {
+					if seen[id] {
+						t.Fatalf("sort %q: %s repeated on page %d", sort, id, pages)
+					}
+					seen[id] = true
+					got = append(got, id)
+				}
+				if page.NextCursor == "" {
+					break
+				}
+				opts.Cursor = page.NextCursor
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("sort %q: paged %v, want %v", sort, got, want)
+			}
+		}
+	})
+}
+
+func TestListCursorSurvivesWritesBetweenPages(t *testing.T) {
+	forEachStore(t, func(t *testing.T, store ContentStore) {
+		createListFixture(t, store)
+
+		first, err := store.GetAllContent(ListOptions{Sort: "style", Limit: 4})
+		if err != nil {
+			t.Fatalf("GetAllContent: %v", err)
+		}
+		// An item sorting before the cursor does not shift the next page
+		store.CreateContent(models.Content{ID: "aaa", Style: "ink"})
+
+		rest, err := store.GetAllContent(ListOptions{Sort: "style", Cursor: first.NextCursor})
+		if err != nil {
+			t.Fatalf("GetAllContent: %v", err)
+		}
+		if len(first.Items)+len(rest.Items) != 11 {
+			t.Fatalf("pages hold %v and %v, want the 11 original items", ids(first.Items), ids(rest.Items))
+		}
+	})
+}
+
+func TestListRejectsTamperedCursors(t *testing.T) {
+	forEachStore(t, func(t *testing.T, store ContentStore) {
+		createListFixture(t, store)
+		page, err := store.GetAllContent(ListOptions{Sort: "style", Limit: 3})
+		if err != nil || page.NextCursor == "" {
+			t.Fatalf("GetAllContent = %+v, %v", page, err)
+		}
+
+		encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+		for name, test := range map[string]ListOptions{
+			"not base64":     {Sort: "style", Cursor: "not a cursor!"},
+			"truncated":      {Sort: "style", Cursor: page.NextCursor[:len(page.NextCursor)-4]},
+			"not JSON":       {Sort: "style", Cursor: encode("style|id-03")},
+			"missing ID":     {Sort: "style", Cursor: encode(`{"s":"style","v":"ink"}`)},
+			"wrong type":     {Sort: "style", Cursor: encode(`{"s":"style","v":7,"id":"id-03"}`)},
+			"another sort":   {Sort: "-style", Cursor: page.NextCursor},
+			"sort rewritten": {Sort: "created_at", Cursor: encode(`{"s":"style","v":"ink","id":"id-03"}`)},
+		} {
+			if err := test.Validate(); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("%s: Validate = %v, want ErrInvalidCursor", name, err)
+			}
+			if _, err := store.GetAllContent(test); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("%s: GetAllContent = %v, want ErrInvalidCursor", name, err)
+			}
+		}
+
+		if _, err := store.GetAllContent(ListOptions{Sort: "prompt"}); !errors.Is(err, ErrInvalidSort) {
+			t.Errorf("unknown sort = %v, want ErrInvalidSort", err)
+		}
+	})
+}
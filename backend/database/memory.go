@@ -1,6 +1,7 @@
 package database
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
@@ -40,33 +41,19 @@ func (db *MemoryDB) GetContent(id string) (*models.Content, error) {
 	return nil, nil // Content not found
 }
 
-// GetAllContent retrieves all content with optional filtering
-func (db *MemoryDB) GetAllContent(limit, offset int, userID string) ([]models.Content, int, error) {
+// GetAllContent retrieves a sorted page of content with optional filtering
+func (db *MemoryDB) GetAllContent(opts ListOptions) (ListResult, error) {
 	db.mutex.RLock()
 	defer db.mutex.RUnlock()
-	
-	var contentList []models.Content
+
+	var candidates []models.Content
 	for _, content := range db.content {
-		// Filter by user if specified
-		if userID != "" && content.UserID != userID {
-			continue
+		if opts.Filter.Matches(&content) {
+			candidates = append(candidates, content)
 		}
-		contentList = append(contentList, content)
-	}
-	
-	total := len(contentList)
-	
-	// Apply pagination
-	if offset >= total {
-		return []models.Content{}, total, nil
-	}
-	
-	end := offset + limit
-	if end > total {
-		end = total
 	}
-	
-	return contentList[offset:end], total, nil
+
+	return listContent(candidates, opts)
 }
 
 // UpdateContent updates an existing content item
@@ -74,10 +61,15 @@ func (db *MemoryDB) UpdateContent(content models.Content) error {
 	db.mutex.Lock()
 	defer db.mutex.Unlock()
 	
-	if _, exists := db.content[content.ID]; !exists {
-		return nil // Content not found
+	existing, exists := db.content[content.ID]
+	if !exists {
+		return ErrNotFound
+	}
+	if existing.Version != content.Version {
+		return ErrVersionConflict
 	}
 	
+	content.Version++
 	content.UpdatedAt = time.Now()
 	db.content[content.ID] = content
 	return nil
@@ -119,6 +111,11 @@ func (db *MemoryDB) SearchContent(query string, limit int) ([]models.Content, er
 	return results, nil
 }
 
+// Backup is not supported for the in-memory database
+func (db *MemoryDB) Backup() error {
+	return fmt.Errorf("memory database does not support backups")
+}
+
 // contains is a helper function for simple text search
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || 
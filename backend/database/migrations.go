@@ -0,0 +1,144 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// migration is one versioned step of the SQLite schema. Steps are applied in
+// order, each inside its own transaction, and recorded in schema_migrations so
+// they run exactly once per database file.
+type migration struct {
+	version    int
+	name       string
+	statements []string
+}
+
+// sqliteMigrations is the full schema history. Append new steps; never edit
+// or reorder ones that have shipped.
+var sqliteMigrations = []migration{
+	{
+		version: 1,
+		name:    "create content table",
+		statements: []string{
+			`CREATE TABLE content (
+				id           TEXT PRIMARY KEY,
+				prompt       TEXT NOT NULL DEFAULT '',
+				style        TEXT NOT NULL DEFAULT '',
+				image_url    TEXT NOT NULL DEFAULT '',
+				image_data   TEXT NOT NULL DEFAULT '',
+				content_hash TEXT NOT NULL DEFAULT '',
+				seed         INTEGER NOT NULL DEFAULT 0,
+				cfg_scale    REAL NOT NULL DEFAULT 0,
+				steps        INTEGER NOT NULL DEFAULT 0,
+				height       INTEGER NOT NULL DEFAULT 0,
+				width        INTEGER NOT NULL DEFAULT 0,
+				model        TEXT NOT NULL DEFAULT '',
+				generated_at INTEGER,
+				created_at   INTEGER,
+				updated_at   INTEGER,
+				user_id      TEXT NOT NULL DEFAULT '',
+				is_public    INTEGER NOT NULL DEFAULT 0,
+				is_licensed  INTEGER NOT NULL DEFAULT 0,
+				license_type TEXT NOT NULL DEFAULT '',
+				nft_minted   INTEGER NOT NULL DEFAULT 0,
+				nft_token_id TEXT NOT NULL DEFAULT ''
+			)`,
+		},
+	},
+	{
+		version: 2,
+		name:    "index content lookups",
+		statements: []string{
+			`CREATE INDEX idx_content_user_id ON content (user_id)`,
+			`CREATE INDEX idx_content_created_at ON content (created_at)`,
+			`CREATE INDEX idx_content_style ON content (style)`,
+			`CREATE INDEX idx_content_model ON content (model)`,
+		},
+	},
+	{
+		version: 3,
+		name:    "add image blob reference",
+		statements: []string{
+			`ALTER TABLE content ADD COLUMN image_blob TEXT NOT NULL DEFAULT ''`,
+			`ALTER TABLE content ADD COLUMN image_size INTEGER NOT NULL DEFAULT 0`,
+			`ALTER TABLE content ADD COLUMN mime_type TEXT NOT NULL DEFAULT ''`,
+			`CREATE INDEX idx_content_image_blob ON content (image_blob)`,
+		},
+	},
+	{
+		version: 4,
+		name:    "add content version",
+		statements: []string{
+			`ALTER TABLE content ADD COLUMN version INTEGER NOT NULL DEFAULT 0`,
+		},
+	},
+	{
+		version: 5,
+		name:    "add moderation flag",
+		statements: []string{
+			`ALTER TABLE content ADD COLUMN hidden INTEGER NOT NULL DEFAULT 0`,
+		},
+	},
+	{
+		version: 6,
+		name:    "add keccak content hash and hash lookup",
+		statements: []string{
+			`ALTER TABLE content ADD COLUMN content_keccak TEXT NOT NULL DEFAULT ''`,
+			`CREATE INDEX idx_content_hash ON content (content_hash COLLATE NOCASE)`,
+		},
+	},
+}
+
+// migrate brings the schema up to the latest version
+func migrate(db *sql.DB, migrations []migration) error {
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		name       TEXT NOT NULL,
+		applied_at INTEGER NOT NULL
+	)`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %v", err)
+	}
+
+	var current int
+	if err := db.QueryRow(`SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&current); err != nil {
+		return fmt.Errorf("failed to read schema version: %v", err)
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		if err := applyMigration(db, m); err != nil {
+			return fmt.Errorf("migration %d (%s) failed: %v", m.version, m.name, err)
+		}
+		fmt.Printf("🗄️ Applied migration %d: %s\n", m.version, m.name)
+	}
+
+	return nil
+}
+
+// applyMigration runs a single migration and records it atomically
+func applyMigration(db *sql.DB, m migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range m.statements {
+		if _, err := tx.Exec(stmt); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(
+		`INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)`,
+		m.version, m.name, time.Now().Unix(),
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
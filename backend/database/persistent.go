@@ -1,10 +1,7 @@
 package database
 
 import (
-	"encoding/json"
 	"fmt"
-	"os"
-	"path/filepath"
 	"sync"
 	"time"
 
@@ -13,68 +10,44 @@ import (
 
 // PersistentDB provides persistent storage for content
 type PersistentDB struct {
-	content map[string]models.Content
-	mutex   sync.RWMutex
+	content  map[string]models.Content
+	mutex    sync.RWMutex
+	file     *jsonFile
 	filePath string
 }
 
-// NewPersistentDB creates a new persistent database instance
-func NewPersistentDB() *PersistentDB {
+// NewPersistentDB creates a new persistent database instance, recovering its
+// state from the snapshot and journal on disk
+func NewPersistentDB() (*PersistentDB, error) {
 	db := &PersistentDB{
-		content:  make(map[string]models.Content),
 		filePath: "data/content.json",
 	}
-	
-	// Load existing data
-	db.loadFromDisk()
-	
-	// Ensure data directory exists
-	os.MkdirAll(filepath.Dir(db.filePath), 0755)
-	
-	return db
-}
 
-// loadFromDisk loads content from JSON file
-func (db *PersistentDB) loadFromDisk() {
-	data, err := os.ReadFile(db.filePath)
+	file, content, err := openJSONFile(db.filePath)
 	if err != nil {
-		// File doesn't exist yet, start with empty database
-		return
-	}
-	
-	var contentList []models.Content
-	if err := json.Unmarshal(data, &contentList); err != nil {
-		fmt.Printf("Warning: Could not load content from disk: %v\n", err)
-		return
-	}
-	
-	// Convert slice to map
-	for _, content := range contentList {
-		db.content[content.ID] = content
+		return nil, err
 	}
-	
+	db.file = file
+	db.content = content
+
 	fmt.Printf("✅ Loaded %d content items from disk\n", len(db.content))
+	return db, nil
 }
 
-// saveToDisk saves content to JSON file
-func (db *PersistentDB) saveToDisk() error {
-	// Create a copy of the content map to avoid deadlock
-	db.mutex.RLock()
-	contentList := make([]models.Content, 0, len(db.content))
-	for _, content := range db.content {
-		contentList = append(contentList, content)
-	}
-	db.mutex.RUnlock()
-	
-	data, err := json.MarshalIndent(contentList, "", "  ")
-	if err != nil {
-		return fmt.Errorf("failed to marshal content: %v", err)
+// write journals an operation and applies it in memory. Callers must hold
+// the write lock.
+func (db *PersistentDB) write(op journalOp) error {
+	if err := db.file.record(op); err != nil {
+		return fmt.Errorf("failed to save to disk: %v", err)
 	}
-	
-	if err := os.WriteFile(db.filePath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write to disk: %v", err)
+	op.apply(db.content)
+
+	if db.file.needsCompaction() {
+		if err := db.file.compact(db.content); err != nil {
+			// The journal still holds every operation, so nothing is lost
+			fmt.Printf("Warning: Failed to compact journal: %v\n", err)
+		}
 	}
-	
 	return nil
 }
 
@@ -82,15 +55,8 @@ func (db *PersistentDB) saveToDisk() error {
 func (db *PersistentDB) CreateContent(content models.Content) error {
 	db.mutex.Lock()
 	defer db.mutex.Unlock()
-	
-	db.content[content.ID] = content
-	
-	// Save to disk
-	if err := db.saveToDisk(); err != nil {
-		fmt.Printf("Warning: Failed to save to disk: %v\n", err)
-	}
-	
-	return nil
+
+	return db.write(journalOp{Op: opPut, ID: content.ID, Content: &content})
 }
 
 // GetContent retrieves content by ID
@@ -104,33 +70,19 @@ func (db *PersistentDB) GetContent(id string) (*models.Content, error) {
 	return nil, nil // Content not found
 }
 
-// GetAllContent retrieves all content with optional filtering
-func (db *PersistentDB) GetAllContent(limit, offset int, userID string) ([]models.Content, int, error) {
+// GetAllContent retrieves a sorted page of content with optional filtering
+func (db *PersistentDB) GetAllContent(opts ListOptions) (ListResult, error) {
 	db.mutex.RLock()
 	defer db.mutex.RUnlock()
-	
-	var contentList []models.Content
+
+	var candidates []models.Content
 	for _, content := range db.content {
-		// Filter by user if specified
-		if userID != "" && content.UserID != userID {
-			continue
+		if opts.Filter.Matches(&content) {
+			candidates = append(candidates, content)
 		}
-		contentList = append(contentList, content)
-	}
-	
-	total := len(contentList)
-	
-	// Apply pagination
-	if offset >= total {
-		return []models.Content{}, total, nil
 	}
-	
-	end := offset + limit
-	if end > total {
-		end = total
-	}
-	
-	return contentList[offset:end], total, nil
+
+	return listContent(candidates, opts)
 }
 
 // UpdateContent updates an existing content item
@@ -138,19 +90,17 @@ func (db *PersistentDB) UpdateContent(content models.Content) error {
 	db.mutex.Lock()
 	defer db.mutex.Unlock()
 	
-	if _, exists := db.content[content.ID]; !exists {
-		return nil // Content not found
+	existing, exists := db.content[content.ID]
+	if !exists {
+		return ErrNotFound
 	}
-	
-	content.UpdatedAt = time.Now()
-	db.content[content.ID] = content
-	
-	// Save to disk
-	if err := db.saveToDisk(); err != nil {
-		fmt.Printf("Warning: Failed to save to disk: %v\n", err)
+	if existing.Version != content.Version {
+		return ErrVersionConflict
 	}
 	
-	return nil
+	content.Version++
+	content.UpdatedAt = time.Now()
+	return db.write(journalOp{Op: opPut, ID: content.ID, Content: &content})
 }
 
 // DeleteContent removes content by ID
@@ -158,14 +108,7 @@ func (db *PersistentDB) DeleteContent(id string) error {
 	db.mutex.Lock()
 	defer db.mutex.Unlock()
 	
-	delete(db.content, id)
-	
-	// Save to disk
-	if err := db.saveToDisk(); err != nil {
-		fmt.Printf("Warning: Failed to save to disk: %v\n", err)
-	}
-	
-	return nil
+	return db.write(journalOp{Op: opDelete, ID: id})
 }
 
 // GetContentCount returns the total number of content items
@@ -205,23 +148,22 @@ func (db *PersistentDB) GetFilePath() string {
 // Backup creates a backup of the database
 func (db *PersistentDB) Backup() error {
 	backupPath := fmt.Sprintf("%s.backup.%d", db.filePath, time.Now().Unix())
-	
+
 	db.mutex.RLock()
-	contentList := make([]models.Content, 0, len(db.content))
-	for _, content := range db.content {
-		contentList = append(contentList, content)
-	}
-	db.mutex.RUnlock()
-	
-	data, err := json.MarshalIndent(contentList, "", "  ")
-	if err != nil {
-		return fmt.Errorf("failed to marshal content for backup: %v", err)
-	}
-	
-	if err := os.WriteFile(backupPath, data, 0644); err != nil {
+	defer db.mutex.RUnlock()
+
+	if err := writeSnapshot(backupPath, db.content); err != nil {
 		return fmt.Errorf("failed to create backup: %v", err)
 	}
-	
+
 	fmt.Printf("✅ Database backed up to: %s\n", backupPath)
 	return nil
 }
+
+// Close folds the journal into a final snapshot
+func (db *PersistentDB) Close() error {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	return db.file.close(db.content)
+}
@@ -1,166 +1,262 @@
 package database
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
+	"sync"
 	"time"
 
 	"licenz-backend/models"
 )
 
-// SimplePersistentDB provides simple persistent storage for content
+const (
+	// maxWriteBatch caps how many queued writes share one journal fsync
+	maxWriteBatch = 64
+	// compactInterval is how often an idle writer folds the journal into a snapshot
+	compactInterval = time.Minute
+)
+
+// ErrClosed is returned by writes issued after the database was closed
+var ErrClosed = errors.New("database is closed")
+
+// SimplePersistentDB provides simple persistent storage for content.
+//
+// Reads are served from memory under a read lock. All writes funnel through
+// a single writer goroutine, which drains whatever is queued, journals the
+// whole batch with one fsync and then applies it under the write lock, so
+// concurrent requests never race on the map and never interleave on disk.
 type SimplePersistentDB struct {
 	content  map[string]models.Content
+	mutex    sync.RWMutex
+	file     *jsonFile
 	filePath string
+
+	writes    chan writeRequest
+	closed    chan struct{}
+	stopped   chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// writeRequest is a single mutation waiting for the writer goroutine
+type writeRequest struct {
+	op      journalOp
+	update  bool  // reject the write if op.ID is missing or not at version
+	version int64 // stored version an update expects
+	result  chan error
 }
 
-// NewSimplePersistentDB creates a new simple persistent database instance
-func NewSimplePersistentDB() *SimplePersistentDB {
-	db := &SimplePersistentDB{
-		content:  make(map[string]models.Content),
-		filePath: "data/content.json",
+// NewSimplePersistentDB creates a new simple persistent database instance,
+// recovering its state from the snapshot and journal on disk
+func NewSimplePersistentDB() (*SimplePersistentDB, error) {
+	db, err := loadSimplePersistentDB("data/content.json")
+	if err != nil {
+		return nil, err
 	}
-	
-	// Ensure data directory exists
-	os.MkdirAll(filepath.Dir(db.filePath), 0755)
-	
-	// Load existing data
-	db.loadFromDisk()
-	
-	return db
+	go db.writer()
+
+	fmt.Printf("✅ Loaded %d content items from disk\n", len(db.content))
+	return db, nil
 }
 
-// loadFromDisk loads content from JSON file
-func (db *SimplePersistentDB) loadFromDisk() {
-	data, err := os.ReadFile(db.filePath)
+// loadSimplePersistentDB recovers the database at filePath without
+// starting its writer
+func loadSimplePersistentDB(filePath string) (*SimplePersistentDB, error) {
+	file, content, err := openJSONFile(filePath)
 	if err != nil {
-		// File doesn't exist yet, start with empty database
-		fmt.Printf("📁 No existing database found, starting fresh\n")
+		return nil, err
+	}
+	return &SimplePersistentDB{
+		content:  content,
+		file:     file,
+		filePath: filePath,
+		writes:   make(chan writeRequest),
+		closed:   make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}, nil
+}
+
+// writer owns every mutation of content and of the files on disk
+func (db *SimplePersistentDB) writer() {
+	defer close(db.stopped)
+
+	ticker := time.NewTicker(compactInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case req := <-db.writes:
+			batch := []writeRequest{req}
+		drain:
+			for len(batch) < maxWriteBatch {
+				select {
+				case req := <-db.writes:
+					batch = append(batch, req)
+				default:
+					break drain
+				}
+			}
+			db.commit(batch)
+
+		case <-ticker.C:
+			if db.file.entries > 0 {
+				db.compact()
+			}
+
+		case <-db.closed:
+			db.mutex.RLock()
+			db.closeErr = db.file.close(db.content)
+			db.mutex.RUnlock()
+			return
+		}
+	}
+}
+
+// commit validates, journals and applies a batch of writes. Only the writer
+// goroutine mutates content, so it may read the map without locking.
+func (db *SimplePersistentDB) commit(batch []writeRequest) {
+	// Track changes made earlier in this batch; nil marks a delete
+	staged := make(map[string]*models.Content)
+	current := func(id string) *models.Content {
+		if content, seen := staged[id]; seen {
+			return content
+		}
+		if content, ok := db.content[id]; ok {
+			return &content
+		}
+		return nil
+	}
+
+	accepted := make([]writeRequest, 0, len(batch))
+	ops := make([]journalOp, 0, len(batch))
+	for _, req := range batch {
+		if req.update {
+			existing := current(req.op.ID)
+			if existing == nil {
+				req.result <- ErrNotFound
+				continue
+			}
+			if existing.Version != req.version {
+				req.result <- ErrVersionConflict
+				continue
+			}
+		}
+		staged[req.op.ID] = req.op.Content
+		accepted = append(accepted, req)
+		ops = append(ops, req.op)
+	}
+	if len(ops) == 0 {
 		return
 	}
 
-	
-	var contentList []models.Content
-	if err := json.Unmarshal(data, &contentList); err != nil {
-		fmt.Printf("⚠️ Warning: Could not load content from disk: %v\n", err)
+	if err := db.file.record(ops...); err != nil {
+		fmt.Printf("⚠️ Warning: Failed to save to disk: %v\n", err)
+		for _, req := range accepted {
+			req.result <- err
+		}
 		return
 	}
-	
-	// Convert slice to map
-	for _, content := range contentList {
-		db.content[content.ID] = content
+
+	db.mutex.Lock()
+	for _, op := range ops {
+		op.apply(db.content)
 	}
-	
-	fmt.Printf("✅ Loaded %d content items from disk\n", len(db.content))
-}
+	db.mutex.Unlock()
 
-// saveToDisk saves content to JSON file
-func (db *SimplePersistentDB) saveToDisk() error {
-	contentList := make([]models.Content, 0, len(db.content))
-	for _, content := range db.content {
-		contentList = append(contentList, content)
+	for _, req := range accepted {
+		req.result <- nil
 	}
-	
-	data, err := json.MarshalIndent(contentList, "", "  ")
-	if err != nil {
-		return fmt.Errorf("failed to marshal content: %v", err)
+
+	if db.file.needsCompaction() {
+		db.compact()
 	}
-	
-	if err := os.WriteFile(db.filePath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write to disk: %v", err)
+}
+
+// compact folds the journal into a fresh snapshot
+func (db *SimplePersistentDB) compact() {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	if err := db.file.compact(db.content); err != nil {
+		// The journal still holds every operation, so nothing is lost
+		fmt.Printf("⚠️ Warning: Failed to compact journal: %v\n", err)
 	}
-	
-	return nil
+}
+
+// submit hands a write to the writer goroutine and waits for it to be durable
+func (db *SimplePersistentDB) submit(req writeRequest) error {
+	req.result = make(chan error, 1)
+
+	select {
+	case db.writes <- req:
+	case <-db.closed:
+		return ErrClosed
+	}
+	return <-req.result
 }
 
 // CreateContent stores a new content item
 func (db *SimplePersistentDB) CreateContent(content models.Content) error {
-	db.content[content.ID] = content
-	
-	// Save to disk
-	if err := db.saveToDisk(); err != nil {
-		fmt.Printf("⚠️ Warning: Failed to save to disk: %v\n", err)
+	if err := db.submit(writeRequest{op: journalOp{Op: opPut, ID: content.ID, Content: &content}}); err != nil {
 		return err
 	}
-	
+
 	fmt.Printf("💾 Saved content %s to disk\n", content.ID)
 	return nil
 }
 
 // GetContent retrieves content by ID
 func (db *SimplePersistentDB) GetContent(id string) (*models.Content, error) {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
 	if content, exists := db.content[id]; exists {
 		return &content, nil
 	}
 	return nil, nil // Content not found
 }
 
-// GetAllContent retrieves all content with optional filtering
-func (db *SimplePersistentDB) GetAllContent(limit, offset int, userID string) ([]models.Content, int, error) {
-	var contentList []models.Content
+// GetAllContent retrieves a sorted page of content with optional filtering
+func (db *SimplePersistentDB) GetAllContent(opts ListOptions) (ListResult, error) {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	var candidates []models.Content
 	for _, content := range db.content {
-		// Filter by user if specified
-		if userID != "" && content.UserID != userID {
-			continue
+		if opts.Filter.Matches(&content) {
+			candidates = append(candidates, content)
 		}
-		contentList = append(contentList, content)
-	}
-	
-	total := len(contentList)
-	
-	// Apply pagination
-	if offset >= total {
-		return []models.Content{}, total, nil
 	}
-	
-	end := offset + limit
-	if end > total {
-		end = total
-	}
-	
-	return contentList[offset:end], total, nil
+
+	return listContent(candidates, opts)
 }
 
 // UpdateContent updates an existing content item
 func (db *SimplePersistentDB) UpdateContent(content models.Content) error {
-	if _, exists := db.content[content.ID]; !exists {
-		return fmt.Errorf("content not found")
-	}
-	
+	expected := content.Version
+	content.Version++
 	content.UpdatedAt = time.Now()
-	db.content[content.ID] = content
-	
-	// Save to disk
-	if err := db.saveToDisk(); err != nil {
-		fmt.Printf("⚠️ Warning: Failed to save to disk: %v\n", err)
-		return err
-	}
-	
-	return nil
+	return db.submit(writeRequest{op: journalOp{Op: opPut, ID: content.ID, Content: &content}, update: true, version: expected})
 }
 
 // DeleteContent removes content by ID
 func (db *SimplePersistentDB) DeleteContent(id string) error {
-	delete(db.content, id)
-	
-	// Save to disk
-	if err := db.saveToDisk(); err != nil {
-		fmt.Printf("⚠️ Warning: Failed to save to disk: %v\n", err)
-		return err
-	}
-	
-	return nil
+	return db.submit(writeRequest{op: journalOp{Op: opDelete, ID: id}})
 }
 
 // GetContentCount returns the total number of content items
 func (db *SimplePersistentDB) GetContentCount() (int, error) {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
 	return len(db.content), nil
 }
 
 // SearchContent searches content by prompt or style
 func (db *SimplePersistentDB) SearchContent(query string, limit int) ([]models.Content, error) {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
 	var results []models.Content
 	for _, content := range db.content {
 		// Simple text search
@@ -171,7 +267,7 @@ func (db *SimplePersistentDB) SearchContent(query string, limit int) ([]models.C
 			}
 		}
 	}
-	
+
 	return results, nil
 }
 
@@ -183,22 +279,24 @@ func (db *SimplePersistentDB) GetFilePath() string {
 // Backup creates a backup of the database
 func (db *SimplePersistentDB) Backup() error {
 	backupPath := fmt.Sprintf("%s.backup.%d", db.filePath, time.Now().Unix())
-	
-	contentList := make([]models.Content, 0, len(db.content))
-	for _, content := range db.content {
-		contentList = append(contentList, content)
-	}
-	
-	data, err := json.MarshalIndent(contentList, "", "  ")
-	if err != nil {
-		return fmt.Errorf("failed to marshal content for backup: %v", err)
-	}
-	
-	if err := os.WriteFile(backupPath, data, 0644); err != nil {
+
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	if err := writeSnapshot(backupPath, db.content); err != nil {
 		return fmt.Errorf("failed to create backup: %v", err)
 	}
-	
+
 	fmt.Printf("✅ Database backed up to: %s\n", backupPath)
 	return nil
 }
 
+// Close stops the writer, folds the journal into a final snapshot and
+// rejects any further writes with ErrClosed
+func (db *SimplePersistentDB) Close() error {
+	db.closeOnce.Do(func() {
+		close(db.closed)
+		<-db.stopped
+	})
+	return db.closeErr
+}
@@ -0,0 +1,259 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"licenz-backend/models"
+)
+
+// openTestDB opens a SimplePersistentDB in a fresh working directory
+func openTestDB(t *testing.T) *SimplePersistentDB {
+	t.Helper()
+	t.Chdir(t.TempDir())
+
+	db, err := NewSimplePersistentDB()
+	if err != nil {
+		t.Fatalf("NewSimplePersistentDB: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestSimplePersistentDBConcurrentReadersAndWriters(t *testing.T) {
+	db := openTestDB(t)
+
+	const writers, perWriter, readers = 8, 40, 8
+
+	stop := make(chan struct{})
+	var readWG sync.WaitGroup
+	for r := 0; r < readers; r++ {
+		readWG.Add(1)
+		go func(r int) {
+			defer readWG.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if _, err := db.GetAllContent(ListOptions{Limit: 20}); err != nil {
+					t.Errorf("GetAllContent: %v", err)
+				}
+				if _, err := db.GetContent(fmt.Sprintf("w%d-%d", r, 0)); err != nil {
+					t.Errorf("GetContent: %v", err)
+				}
+				if _, err := db.SearchContent("prompt", 10); err != nil {
+					t.Errorf("SearchContent: %v", err)
+				}
+				if _, err := db.GetContentCount(); err != nil {
+					t.Errorf("GetContentCount: %v", err)
+				}
+			}
+		}(r)
+	}
+
+	var writeWG sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		writeWG.Add(1)
+		go func(w int) {
+			defer writeWG.Done()
+			for i := 0; i < perWriter; i++ {
+				id := fmt.Sprintf("w%d-%d", w, i)
+				content := models.Content{ID: id, Prompt: "prompt " + id, UserID: fmt.Sprintf("user-%d", w)}
+				if err := db.CreateContent(content); err != nil {
+					t.Errorf("CreateContent(%s): %v", id, err)
+					continue
+				}
+				content.IsLicensed = true
+				if err := db.UpdateContent(content); err != nil {
+					t.Errorf("UpdateContent(%s): %v", id, err)
+				}
+				// Delete every fourth item again
+				if i%4 == 0 {
+					if err := db.DeleteContent(id); err != nil {
+						t.Errorf("DeleteContent(%s): %v", id, err)
+					}
+				}
+			}
+		}(w)
+	}
+
+	writeWG.Wait()
+	close(stop)
+	readWG.Wait()
+
+	want := writers * (perWriter - perWriter/4)
+	if count, _ := db.GetContentCount(); count != want {
+		t.Fatalf("count = %d, want %d", count, want)
+	}
+
+	got, err := db.GetContent("w3-1")
+	if err != nil || got == nil || !got.IsLicensed {
+		t.Fatalf("GetContent(w3-1) = %+v, %v; want licensed content", got, err)
+	}
+
+	// Everything acknowledged must survive a restart
+	if err := db.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	reopened, err := NewSimplePersistentDB()
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer reopened.Close()
+
+	if count, _ := reopened.GetContentCount(); count != want {
+		t.Fatalf("count after reopen = %d, want %d", count, want)
+	}
+}
+
+func TestSimplePersistentDBUpdateMissingContent(t *testing.T) {
+	db := openTestDB(t)
+
+	if err := db.UpdateContent(models.Content{ID: "missing"}); err == nil {
+		t.Fatal("UpdateContent of missing content succeeded")
+	}
+	if count, _ := db.GetContentCount(); count != 0 {
+		t.Fatalf("count = %d, want 0", count)
+	}
+}
+
+// commitBatch queues reqs before starting db's writer, so its first drain
+// takes them all as one batch, and returns each request's result
+func commitBatch(db *SimplePersistentDB, reqs ...writeRequest) []error {
+	db.writes = make(chan writeRequest, len(reqs))
+	for i := range reqs {
+		reqs[i].result = make(chan error, 1)
+		db.writes <- reqs[i]
+	}
+	go db.writer()
+
+	results := make([]error, len(reqs))
+	for i, req := range reqs {
+		results[i] = <-req.result
+	}
+	return results
+}
+
+func TestSimplePersistentDBDeleteThenUpdateInSameBatch(t *testing.T) {
+	db := openTestDB(t)
+	if err := db.CreateContent(models.Content{ID: "a", Version: 1}); err != nil {
+		t.Fatalf("CreateContent: %v", err)
+	}
+	db.Close()
+
+	idle, err := loadSimplePersistentDB(db.filePath)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	defer idle.Close()
+
+	// The update sees the delete staged ahead of it in the batch
+	updated := models.Content{ID: "a", Prompt: "resurrected", Version: 2}
+	results := commitBatch(idle,
+		writeRequest{op: journalOp{Op: opDelete, ID: "a"}},
+		writeRequest{op: journalOp{Op: opPut, ID: "a", Content: &updated}, update: true, version: 1},
+	)
+	if results[0] != nil || !errors.Is(results[1], ErrNotFound) {
+		t.Fatalf("batch results = %v, want delete ok and update ErrNotFound", results)
+	}
+	if got, _ := idle.GetContent("a"); got != nil {
+		t.Fatalf("content after the batch = %+v, want it deleted", got)
+	}
+
+	// Only the delete was journaled
+	if err := idle.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	reopened, err := NewSimplePersistentDB()
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer reopened.Close()
+	if got, _ := reopened.GetContent("a"); got != nil {
+		t.Fatalf("content after reopening = %+v, want it deleted", got)
+	}
+}
+
+func TestSimplePersistentDBRejectsWritesAfterClose(t *testing.T) {
+	db := openTestDB(t)
+
+	if err := db.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := db.CreateContent(models.Content{ID: "late"}); err != ErrClosed {
+		t.Fatalf("CreateContent after Close = %v, want ErrClosed", err)
+	}
+	// Closing twice is harmless
+	if err := db.Close(); err != nil {
+		t.Fatalf("second Close: %v", err)
+	}
+}
+
+func TestPersistentDBWritesDoNotDeadlock(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	db, err := NewPersistentDB()
+	if err != nil {
+		t.Fatalf("NewPersistentDB: %v", err)
+	}
+	defer db.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				id := fmt.Sprint(i)
+				db.CreateContent(models.Content{ID: id})
+				db.UpdateContent(models.Content{ID: id, Prompt: "updated"})
+				db.GetAllContent(ListOptions{Limit: 10})
+			}(i)
+		}
+		wg.Wait()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("PersistentDB writes deadlocked")
+	}
+
+	if count, _ := db.GetContentCount(); count != 20 {
+		t.Fatalf("count = %d, want 20", count)
+	}
+}
+
+func TestSimplePersistentDBRejectsStaleUpdates(t *testing.T) {
+	db := openTestDB(t)
+
+	if err := db.CreateContent(models.Content{ID: "a"}); err != nil {
+		t.Fatalf("CreateContent: %v", err)
+	}
+
+	first, _ := db.GetContent("a")
+	second, _ := db.GetContent("a")
+
+	first.IsPublic = true
+	if err := db.UpdateContent(*first); err != nil {
+		t.Fatalf("UpdateContent: %v", err)
+	}
+
+	// second was read before the first update landed
+	second.LicenseType = "commercial"
+	if err := db.UpdateContent(*second); err != ErrVersionConflict {
+		t.Fatalf("stale UpdateContent = %v, want ErrVersionConflict", err)
+	}
+
+	got, _ := db.GetContent("a")
+	if got.Version != 1 || !got.IsPublic || got.LicenseType != "" {
+		t.Fatalf("GetContent = %+v; want version 1 with only the first update", got)
+	}
+}
@@ -0,0 +1,430 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"licenz-backend/models"
+	_ "modernc.org/sqlite"
+)
+
+// contentColumns lists the content table columns in the order scanContent reads them
+const contentColumns = `id, prompt, style, image_url, image_data, content_hash, seed,
+	cfg_scale, steps, height, width, model, generated_at, created_at, updated_at,
+	user_id, is_public, is_licensed, license_type, nft_minted, nft_token_id,
+	image_blob, image_size, mime_type, version, hidden, content_keccak`
+
+// SQLiteDB provides transactional storage for content in an embedded SQLite file
+type SQLiteDB struct {
+	db       *sql.DB
+	filePath string
+}
+
+// NewSQLiteDB opens (or creates) the SQLite database at path and migrates it
+// to the latest schema
+func NewSQLiteDB(path string) (*SQLiteDB, error) {
+	// Ensure data directory exists
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create data directory: %v", err)
+	}
+
+	dsn := "file:" + path + "?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)&_pragma=foreign_keys(1)"
+	conn, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite database: %v", err)
+	}
+
+	// SQLite allows a single writer; serializing connections avoids SQLITE_BUSY
+	conn.SetMaxOpenConns(1)
+
+	if err := migrate(conn, sqliteMigrations); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &SQLiteDB{db: conn, filePath: path}, nil
+}
+
+// CreateContent stores a new content item
+func (db *SQLiteDB) CreateContent(content models.Content) error {
+	if _, err := insertContent(db.db, content, false); err != nil {
+		return fmt.Errorf("failed to insert content: %v", err)
+	}
+	return nil
+}
+
+// GetContent retrieves content by ID
+func (db *SQLiteDB) GetContent(id string) (*models.Content, error) {
+	row := db.db.QueryRow(`SELECT `+contentColumns+` FROM content WHERE id = ?`, id)
+
+	content, err := scanContent(row)
+	if err == sql.ErrNoRows {
+		return nil, nil // Content not found
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read content: %v", err)
+	}
+	return content, nil
+}
+
+// GetAllContent retrieves a sorted page of content with optional filtering,
+// using keyset pagination when a cursor is supplied
+func (db *SQLiteDB) GetAllContent(opts ListOptions) (ListResult, error) {
+	spec, err := parseSort(opts.Sort)
+	if err != nil {
+		return ListResult{}, err
+	}
+
+	conds, args := opts.Filter.sqlConditions()
+
+	var total int
+	if err := db.db.QueryRow(`SELECT COUNT(*) FROM content`+whereClause(conds), args...).Scan(&total); err != nil {
+		return ListResult{}, fmt.Errorf("failed to count content: %v", err)
+	}
+
+	var facets *models.Facets
+	if opts.Facets {
+		if facets, err = db.facets(conds, args); err != nil {
+			return ListResult{}, err
+		}
+	}
+
+	// Rows strictly after the cursor position in sort order
+	if opts.Cursor != "" {
+		c, err := decodeCursor(opts.Cursor, spec)
+		if err != nil {
+			return ListResult{}, err
+		}
+		op := ">"
+		if spec.desc {
+			op = "<"
+		}
+		var key interface{} = c.Str
+		if spec.field.numeric {
+			key = c.Num
+		}
+		col := spec.field.column
+		conds = append(conds, fmt.Sprintf("(%s %s ? OR (%s = ? AND id %s ?))", col, op, col, op))
+		args = append(args, key, key, c.ID)
+	}
+
+	dir := "ASC"
+	if spec.desc {
+		dir = "DESC"
+	}
+	query := fmt.Sprintf(`SELECT %s FROM content%s ORDER BY %s %s, id %s`,
+		contentColumns, whereClause(conds), spec.field.column, dir, dir)
+
+	// Fetch one extra row to learn whether another page follows
+	if opts.Limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, opts.Limit+1)
+		if opts.Cursor == "" && opts.Offset > 0 {
+			query += ` OFFSET ?`
+			args = append(args, opts.Offset)
+		}
+	} else if opts.Cursor == "" && opts.Offset > 0 {
+		query += ` LIMIT -1 OFFSET ?`
+		args = append(args, opts.Offset)
+	}
+
+	rows, err := db.db.Query(query, args...)
+	if err != nil {
+		return ListResult{}, fmt.Errorf("failed to list content: %v", err)
+	}
+	contentList, err := scanContentRows(rows)
+	if err != nil {
+		return ListResult{}, err
+	}
+
+	result := ListResult{Items: contentList, Total: total, Facets: facets}
+	if opts.Limit > 0 && len(contentList) > opts.Limit {
+		result.Items = contentList[:opts.Limit]
+		result.NextCursor = spec.cursorAfter(&result.Items[opts.Limit-1])
+	}
+	return result, nil
+}
+
+// facetColumns maps each facet to the SQL expression grouped on; booleans
+// are rendered the same way models.Facets.Add renders them
+var facetColumns = []struct {
+	expr   string
+	counts func(*models.Facets) map[string]int
+}{
+	{"style", func(f *models.Facets) map[string]int { return f.Style }},
+	{"model", func(f *models.Facets) map[string]int { return f.Model }},
+	{"CASE WHEN is_licensed THEN 'true' ELSE 'false' END", func(f *models.Facets) map[string]int { return f.IsLicensed }},
+	{"CASE WHEN nft_minted THEN 'true' ELSE 'false' END", func(f *models.Facets) map[string]int { return f.NFTMinted }},
+	{"license_type", func(f *models.Facets) map[string]int { return f.LicenseType }},
+	{"user_id", func(f *models.Facets) map[string]int { return f.Creator }},
+}
+
+// facets counts the rows matching conds per facet value
+func (db *SQLiteDB) facets(conds []string, args []interface{}) (*models.Facets, error) {
+	facets := models.NewFacets()
+
+	for _, col := range facetColumns {
+		query := fmt.Sprintf(`SELECT %s AS value, COUNT(*) FROM content%s GROUP BY value`, col.expr, whereClause(conds))
+		rows, err := db.db.Query(query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count facets: %v", err)
+		}
+
+		counts := col.counts(facets)
+		for rows.Next() {
+			var value string
+			var count int
+			if err := rows.Scan(&value, &count); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to count facets: %v", err)
+			}
+			if value != "" {
+				counts[value] = count
+			}
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to count facets: %v", err)
+		}
+	}
+
+	return facets, nil
+}
+
+// UpdateContent updates an existing content item
+func (db *SQLiteDB) UpdateContent(content models.Content) error {
+	content.UpdatedAt = time.Now()
+
+	// Only the row still at the version the caller read is updated
+	res, err := db.db.Exec(`UPDATE content SET
+		prompt = ?, style = ?, image_url = ?, image_data = ?, content_hash = ?, seed = ?,
+		cfg_scale = ?, steps = ?, height = ?, width = ?, model = ?, generated_at = ?,
+		created_at = ?, updated_at = ?, user_id = ?, is_public = ?, is_licensed = ?,
+		license_type = ?, nft_minted = ?, nft_token_id = ?, image_blob = ?, image_size = ?, mime_type = ?,
+		hidden = ?, content_keccak = ?, version = version + 1
+		WHERE id = ? AND version = ?`,
+		content.Prompt, content.Style, content.ImageURL, content.ImageData, content.ContentHash, content.Seed,
+		content.CFGScale, content.Steps, content.Height, content.Width, content.Model, timeToSQL(content.GeneratedAt),
+		timeToSQL(content.CreatedAt), timeToSQL(content.UpdatedAt), content.UserID, content.IsPublic, content.IsLicensed,
+		content.LicenseType, content.NFTMinted, content.NFTTokenID, content.ImageBlob, content.ImageSize, content.MimeType,
+		content.Hidden, content.ContentKeccak, content.ID, content.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update content: %v", err)
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		var exists int
+		if err := db.db.QueryRow(`SELECT COUNT(*) FROM content WHERE id = ?`, content.ID).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to update content: %v", err)
+		}
+		if exists == 0 {
+			return ErrNotFound
+		}
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// DeleteContent removes content by ID
+func (db *SQLiteDB) DeleteContent(id string) error {
+	if _, err := db.db.Exec(`DELETE FROM content WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete content: %v", err)
+	}
+	return nil
+}
+
+// GetContentCount returns the total number of content items
+func (db *SQLiteDB) GetContentCount() (int, error) {
+	var count int
+	if err := db.db.QueryRow(`SELECT COUNT(*) FROM content`).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count content: %v", err)
+	}
+	return count, nil
+}
+
+// SearchContent searches content by prompt or style
+func (db *SQLiteDB) SearchContent(query string, limit int) ([]models.Content, error) {
+	pattern := "%" + escapeLike(query) + "%"
+
+	rows, err := db.db.Query(
+		`SELECT `+contentColumns+` FROM content
+		WHERE prompt LIKE ? ESCAPE '\' OR style LIKE ? ESCAPE '\'
+		ORDER BY created_at DESC, id DESC LIMIT ?`,
+		pattern, pattern, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search content: %v", err)
+	}
+
+	return scanContentRows(rows)
+}
+
+// GetFilePath returns the database file path
+func (db *SQLiteDB) GetFilePath() string {
+	return db.filePath
+}
+
+// Backup creates a consistent snapshot of the database next to the live file
+func (db *SQLiteDB) Backup() error {
+	backupPath := fmt.Sprintf("%s.backup.%d", db.filePath, time.Now().Unix())
+
+	if _, err := db.db.Exec(`VACUUM INTO ?`, backupPath); err != nil {
+		return fmt.Errorf("failed to create backup: %v", err)
+	}
+
+	fmt.Printf("✅ Database backed up to: %s\n", backupPath)
+	return nil
+}
+
+// ImportJSON ingests a content.json file written by SimplePersistentDB or
+// PersistentDB. Records whose ID already exists are skipped, so the import can
+// safely be re-run. It returns the number of records inserted.
+func (db *SQLiteDB) ImportJSON(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s: %v", path, err)
+	}
+
+	var contentList []models.Content
+	if err := json.Unmarshal(data, &contentList); err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+
+	tx, err := db.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	imported := 0
+	for _, content := range contentList {
+		if content.ID == "" {
+			continue
+		}
+		n, err := insertContent(tx, content, true)
+		if err != nil {
+			return 0, fmt.Errorf("failed to import content %s: %v", content.ID, err)
+		}
+		imported += int(n)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit import: %v", err)
+	}
+
+	return imported, nil
+}
+
+// Close releases the underlying database handle
+func (db *SQLiteDB) Close() error {
+	return db.db.Close()
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+// insertContent writes one content row and reports how many rows were
+// inserted; ignoreExisting turns ID collisions into no-ops
+func insertContent(e execer, content models.Content, ignoreExisting bool) (int64, error) {
+	verb := "INSERT"
+	if ignoreExisting {
+		verb = "INSERT OR IGNORE"
+	}
+
+	res, err := e.Exec(verb+` INTO content (`+contentColumns+`)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		content.ID, content.Prompt, content.Style, content.ImageURL, content.ImageData, content.ContentHash, content.Seed,
+		content.CFGScale, content.Steps, content.Height, content.Width, content.Model,
+		timeToSQL(content.GeneratedAt), timeToSQL(content.CreatedAt), timeToSQL(content.UpdatedAt),
+		content.UserID, content.IsPublic, content.IsLicensed, content.LicenseType, content.NFTMinted, content.NFTTokenID,
+		content.ImageBlob, content.ImageSize, content.MimeType, content.Version, content.Hidden, content.ContentKeccak,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// scanner is satisfied by both *sql.Row and *sql.Rows
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanContent reads one row selected with contentColumns
+func scanContent(s scanner) (*models.Content, error) {
+	var content models.Content
+	var generatedAt, createdAt, updatedAt sql.NullInt64
+
+	err := s.Scan(
+		&content.ID, &content.Prompt, &content.Style, &content.ImageURL, &content.ImageData, &content.ContentHash, &content.Seed,
+		&content.CFGScale, &content.Steps, &content.Height, &content.Width, &content.Model,
+		&generatedAt, &createdAt, &updatedAt,
+		&content.UserID, &content.IsPublic, &content.IsLicensed, &content.LicenseType, &content.NFTMinted, &content.NFTTokenID,
+		&content.ImageBlob, &content.ImageSize, &content.MimeType, &content.Version, &content.Hidden, &content.ContentKeccak,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	content.GeneratedAt = timeFromSQL(generatedAt)
+	content.CreatedAt = timeFromSQL(createdAt)
+	content.UpdatedAt = timeFromSQL(updatedAt)
+	return &content, nil
+}
+
+// scanContentRows drains rows into a slice and closes them
+func scanContentRows(rows *sql.Rows) ([]models.Content, error) {
+	defer rows.Close()
+
+	contentList := []models.Content{}
+	for rows.Next() {
+		content, err := scanContent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read content: %v", err)
+		}
+		contentList = append(contentList, *content)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read content: %v", err)
+	}
+	return contentList, nil
+}
+
+// timeToSQL stores timestamps as Unix nanoseconds so they sort numerically;
+// the zero time is stored as NULL
+func timeToSQL(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t.UnixNano()
+}
+
+// timeFromSQL is the inverse of timeToSQL
+func timeFromSQL(v sql.NullInt64) time.Time {
+	if !v.Valid {
+		return time.Time{}
+	}
+	return time.Unix(0, v.Int64)
+}
+
+// whereClause joins conditions into a WHERE clause, or nothing if there are none
+func whereClause(conds []string) string {
+	if len(conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conds, " AND ")
+}
+
+// escapeLike escapes LIKE wildcards so user input is matched literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
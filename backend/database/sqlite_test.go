@@ -0,0 +1,159 @@
+package database
+
+import (
+	"database/sql"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"licenz-backend/models"
+)
+
+// openTestSQLite opens a SQLiteDB in a temporary directory
+func openTestSQLite(t *testing.T) *SQLiteDB {
+	t.Helper()
+	db, err := NewSQLiteDB(filepath.Join(t.TempDir(), "content.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteDB: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// schemaVersions lists the migrations recorded in db
+func schemaVersions(t *testing.T, db *sql.DB) []int {
+	t.Helper()
+	rows, err := db.Query(`SELECT version FROM schema_migrations ORDER BY version`)
+	if err != nil {
+		t.Fatalf("read schema_migrations: %v", err)
+	}
+	defer rows.Close()
+
+	var versions []int
+	for rows.Next() {
+		var v int
+		rows.Scan(&v)
+		versions = append(versions, v)
+	}
+	return versions
+}
+
+func TestSQLiteImportJSONRoundTrip(t *testing.T) {
+	at := time.Date(2026, 1, 2, 15, 4, 5, 123456789, time.UTC)
+	records := map[string]models.Content{
+		"a": {
+			ID: "a", Prompt: "a lighthouse at dusk", Style: "oil", ImageURL: "/api/content/a/download",
+			ContentHash: "0xabc", ContentKeccak: "0xdef", Seed: 42, CFGScale: 7.5, Steps: 30,
+			Height: 512, Width: 768, Model: "sdxl", GeneratedAt: at, CreatedAt: at, UpdatedAt: at.Add(time.Hour),
+			UserID: "alice", IsPublic: true, IsLicensed: true, LicenseType: "commercial",
+			NFTMinted: true, NFTTokenID: "5", ImageBlob: "abc123", ImageSize: 2048, MimeType: "image/png",
+			Hidden: true, Version: 3,
+		},
+		"b": {ID: "b", Prompt: "legacy record", ImageData: "aGVsbG8=", CreatedAt: at},
+	}
+	path := filepath.Join(t.TempDir(), "content.json")
+	if err := writeSnapshot(path, records); err != nil {
+		t.Fatalf("writeSnapshot: %v", err)
+	}
+
+	db := openTestSQLite(t)
+	if n, err := db.ImportJSON(path); err != nil || n != 2 {
+		t.Fatalf("ImportJSON = %d, %v; want 2 records", n, err)
+	}
+	for id, want := range records {
+		got, err := db.GetContent(id)
+		if err != nil || got == nil {
+			t.Fatalf("GetContent(%s) = %v, %v", id, got, err)
+		}
+		// Times come back in the local zone; compare the instants
+		for _, pair := range [][2]*time.Time{
+			{&got.GeneratedAt, &want.GeneratedAt}, {&got.CreatedAt, &want.CreatedAt}, {&got.UpdatedAt, &want.UpdatedAt},
+		} {
+			if !pair[0].Equal(*pair[1]) {
+				t.Errorf("%s: time %v, want %v", id, *pair[0], *pair[1])
+			}
+			*pair[0] = *pair[1]
+		}
+		if !reflect.DeepEqual(*got, want) {
+			t.Errorf("%s after import = %+v, want %+v", id, *got, want)
+		}
+	}
+
+	// Re-running the import skips records that are already there
+	if n, err := db.ImportJSON(path); err != nil || n != 0 {
+		t.Fatalf("second ImportJSON = %d, %v; want 0", n, err)
+	}
+	if count, _ := db.GetContentCount(); count != 2 {
+		t.Fatalf("count = %d, want 2", count)
+	}
+}
+
+func TestSQLiteMigratesOlderSchema(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "content.db")
+	conn, err := sql.Open("sqlite", "file:"+path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	// A database from before image blobs, versions and moderation
+	if err := migrate(conn, sqliteMigrations[:2]); err != nil {
+		t.Fatalf("migrate to version 2: %v", err)
+	}
+	if _, err := conn.Exec(`INSERT INTO content (id, prompt, created_at) VALUES ('old', 'from v2', ?)`, time.Now().UnixNano()); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	conn.Close()
+
+	db, err := NewSQLiteDB(path)
+	if err != nil {
+		t.Fatalf("NewSQLiteDB: %v", err)
+	}
+	defer db.Close()
+
+	versions := schemaVersions(t, db.db)
+	if len(versions) != len(sqliteMigrations) || versions[len(versions)-1] != sqliteMigrations[len(sqliteMigrations)-1].version {
+		t.Fatalf("schema versions = %v, want all %d migrations", versions, len(sqliteMigrations))
+	}
+
+	got, err := db.GetContent("old")
+	if err != nil || got == nil {
+		t.Fatalf("GetContent = %v, %v", got, err)
+	}
+	if got.Prompt != "from v2" || got.Version != 0 || got.Hidden || got.ImageBlob != "" {
+		t.Fatalf("migrated record = %+v, want the new columns at their defaults", got)
+	}
+
+	// Reopening applies nothing twice
+	db.Close()
+	db, err = NewSQLiteDB(path)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	if again := schemaVersions(t, db.db); !reflect.DeepEqual(again, versions) {
+		t.Fatalf("schema versions after reopen = %v, want %v", again, versions)
+	}
+}
+
+func TestSQLiteFailedMigrationRollsBack(t *testing.T) {
+	db := openTestSQLite(t)
+	latest := sqliteMigrations[len(sqliteMigrations)-1].version
+
+	broken := append(sqliteMigrations[:len(sqliteMigrations):len(sqliteMigrations)], migration{
+		version: latest + 1,
+		name:    "broken",
+		statements: []string{
+			`ALTER TABLE content ADD COLUMN half_done TEXT`,
+			`ALTER TABLE no_such_table ADD COLUMN x TEXT`,
+		},
+	})
+	if err := migrate(db.db, broken); err == nil {
+		t.Fatal("broken migration succeeded")
+	}
+
+	if versions := schemaVersions(t, db.db); versions[len(versions)-1] != latest {
+		t.Fatalf("schema versions = %v, want the broken step unrecorded", versions)
+	}
+	if _, err := db.db.Exec(`SELECT half_done FROM content`); err == nil {
+		t.Fatal("the first statement of the failed migration was kept")
+	}
+}
@@ -0,0 +1,74 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+
+	"licenz-backend/models"
+)
+
+var (
+	// ErrNotFound is returned by UpdateContent for content that does not exist
+	ErrNotFound = errors.New("content not found")
+	// ErrVersionConflict is returned by UpdateContent when the stored content
+	// has changed since the caller read it
+	ErrVersionConflict = errors.New("content was modified concurrently")
+)
+
+// ContentStore is the storage contract the HTTP handlers depend on.
+// MemoryDB, PersistentDB, SimplePersistentDB and SQLiteDB all implement it.
+//
+// UpdateContent is a compare-and-swap: it only succeeds when content.Version
+// equals the stored version, returning ErrVersionConflict otherwise (or
+// ErrNotFound when there is nothing stored under content.ID), and stores the
+// content with Version incremented and UpdatedAt set.
+type ContentStore interface {
+	CreateContent(content models.Content) error
+	GetContent(id string) (*models.Content, error)
+	GetAllContent(opts ListOptions) (ListResult, error)
+	UpdateContent(content models.Content) error
+	DeleteContent(id string) error
+	SearchContent(query string, limit int) ([]models.Content, error)
+	GetContentCount() (int, error)
+	Backup() error
+}
+
+// Compile-time checks that every backend satisfies ContentStore
+var (
+	_ ContentStore = (*MemoryDB)(nil)
+	_ ContentStore = (*PersistentDB)(nil)
+	_ ContentStore = (*SimplePersistentDB)(nil)
+	_ ContentStore = (*SQLiteDB)(nil)
+)
+
+// DefaultSQLitePath is where the sqlite backend keeps its database file
+const DefaultSQLitePath = "data/content.db"
+
+// Config selects and configures a content store backend
+type Config struct {
+	// Backend is one of json (default), persistent, memory or sqlite
+	Backend string
+	// Path is the database file used by the sqlite backend
+	Path string
+}
+
+// Open creates the content store described by cfg.
+// An empty backend selects the JSON file store.
+func Open(cfg Config) (ContentStore, error) {
+	switch cfg.Backend {
+	case "sqlite":
+		path := cfg.Path
+		if path == "" {
+			path = DefaultSQLitePath
+		}
+		return NewSQLiteDB(path)
+	case "", "json":
+		return NewSimplePersistentDB()
+	case "persistent":
+		return NewPersistentDB()
+	case "memory":
+		return NewMemoryDB(), nil
+	default:
+		return nil, fmt.Errorf("unknown database backend %q", cfg.Backend)
+	}
+}
@@ -0,0 +1,117 @@
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"licenz-backend/models"
+)
+
+// storeBackends opens each ContentStore implementation in a fresh working
+// directory, closing it after the test
+var storeBackends = map[string]func(t *testing.T) ContentStore{
+	"memory": func(t *testing.T) ContentStore {
+		return NewMemoryDB()
+	},
+	"persistent": func(t *testing.T) ContentStore {
+		t.Chdir(t.TempDir())
+		db, err := NewPersistentDB()
+		if err != nil {
+			t.Fatalf("NewPersistentDB: %v", err)
+		}
+		t.Cleanup(func() { db.Close() })
+		return db
+	},
+	"simple-persistent": func(t *testing.T) ContentStore {
+		return openTestDB(t)
+	},
+	"sqlite": func(t *testing.T) ContentStore {
+		return openTestSQLite(t)
+	},
+}
+
+// forEachStore runs test against every backend
+func forEachStore(t *testing.T, test func(t *testing.T, store ContentStore)) {
+	for name, open := range storeBackends {
+		t.Run(name, func(t *testing.T) {
+			test(t, open(t))
+		})
+	}
+}
+
+func TestStoreUpdateMissingContent(t *testing.T) {
+	forEachStore(t, func(t *testing.T, store ContentStore) {
+		if err := store.UpdateContent(models.Content{ID: "missing"}); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("UpdateContent of missing content = %v, want ErrNotFound", err)
+		}
+		if count, _ := store.GetContentCount(); count != 0 {
+			t.Fatalf("count = %d, want 0", count)
+		}
+	})
+}
+
+func TestStoreUpdateIsCompareAndSwap(t *testing.T) {
+	forEachStore(t, func(t *testing.T, store ContentStore) {
+		if err := store.CreateContent(models.Content{ID: "a", Version: 1}); err != nil {
+			t.Fatalf("CreateContent: %v", err)
+		}
+		first, _ := store.GetContent("a")
+		second, _ := store.GetContent("a")
+
+		first.IsPublic = true
+		if err := store.UpdateContent(*first); err != nil {
+			t.Fatalf("UpdateContent: %v", err)
+		}
+		second.LicenseType = "commercial"
+		if err := store.UpdateContent(*second); !errors.Is(err, ErrVersionConflict) {
+			t.Fatalf("stale UpdateContent = %v, want ErrVersionConflict", err)
+		}
+
+		got, _ := store.GetContent("a")
+		if got.Version != 2 || !got.IsPublic || got.LicenseType != "" || got.UpdatedAt.IsZero() {
+			t.Fatalf("GetContent = %+v; want version 2 with only the first update", got)
+		}
+	})
+}
+
+func TestStoreCreateGetDelete(t *testing.T) {
+	forEachStore(t, func(t *testing.T, store ContentStore) {
+		created := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+		content := models.Content{
+			ID:          "a",
+			Prompt:      "a lighthouse at dusk",
+			Style:       "oil",
+			ContentHash: "0xabc",
+			CFGScale:    7.5,
+			CreatedAt:   created,
+			UserID:      "alice",
+			IsPublic:    true,
+			Version:     1,
+		}
+		if err := store.CreateContent(content); err != nil {
+			t.Fatalf("CreateContent: %v", err)
+		}
+
+		got, err := store.GetContent("a")
+		if err != nil || got == nil {
+			t.Fatalf("GetContent = %v, %v", got, err)
+		}
+		if got.Prompt != content.Prompt || got.CFGScale != 7.5 || !got.CreatedAt.Equal(created) || got.UserID != "alice" || !got.IsPublic {
+			t.Fatalf("GetContent = %+v, want %+v", got, content)
+		}
+		if count, _ := store.GetContentCount(); count != 1 {
+			t.Fatalf("count = %d, want 1", count)
+		}
+
+		if err := store.DeleteContent("a"); err != nil {
+			t.Fatalf("DeleteContent: %v", err)
+		}
+		if got, err := store.GetContent("a"); got != nil || err != nil {
+			t.Fatalf("GetContent after delete = %+v, %v; want nil, nil", got, err)
+		}
+		if count, _ := store.GetContentCount(); count != 0 {
+			t.Fatalf("count after delete = %d, want 0", count)
+		}
+	})
+}
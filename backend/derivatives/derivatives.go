@@ -0,0 +1,191 @@
+// Package derivatives produces and stores downscaled copies of images.
+// Derivatives are keyed by the original's blob key, so every record that
+// shares an image also shares its thumbnails, and they never go stale: a
+// new image means a new key.
+package derivatives
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // register GIF decoding
+	"image/jpeg"
+	_ "image/png" // register PNG decoding
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"licenz-backend/blobstore"
+	"licenz-backend/fsutil"
+	"licenz-backend/imaging"
+)
+
+// MimeType is the type of every derivative. The standard library has no
+// WebP encoder, so thumbnails are JPEG.
+const MimeType = "image/jpeg"
+
+// DefaultSizes are the thumbnail sizes, as the longest side in pixels
+var DefaultSizes = []int{256, 1024}
+
+// DefaultQuality is the JPEG quality of thumbnails
+const DefaultQuality = 82
+
+// ErrUnsupported is returned for originals that cannot be decoded (WebP)
+var ErrUnsupported = errors.New("thumbnails are not available for this image format")
+
+// errUndecodable is returned for originals whose bytes do not decode
+var errUndecodable = errors.New("failed to decode image")
+
+// ParseSizes reads a comma-separated list of sizes such as "256,1024"
+func ParseSizes(spec string) ([]int, error) {
+	var sizes []int
+	for _, part := range strings.Split(spec, ",") {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		size, err := strconv.Atoi(part)
+		if err != nil || size < 16 || size > 4096 {
+			return nil, fmt.Errorf("invalid thumbnail size %q, want 16 to 4096", part)
+		}
+		sizes = append(sizes, size)
+	}
+	if len(sizes) == 0 {
+		return nil, errors.New("no thumbnail sizes given")
+	}
+	sort.Ints(sizes)
+	return sizes, nil
+}
+
+// Supported reports whether thumbnails can be made from images of mimeType
+func Supported(mimeType string) bool {
+	switch mimeType {
+	case "image/png", "image/jpeg", "image/gif":
+		return true
+	default:
+		return false
+	}
+}
+
+// Generator renders thumbnails of blobs into a directory laid out like the
+// blob store: <dir>/<key[:2]>/<key>_<size>.jpg
+type Generator struct {
+	blobs   blobstore.Store
+	dir     string
+	sizes   []int
+	quality int
+	limits  imaging.Limits
+}
+
+// NewGenerator creates a generator writing thumbnails of sizes under dir.
+// Originals over the pixel limit of limits, which should be the limits
+// uploads were accepted under, are not decoded.
+func NewGenerator(blobs blobstore.Store, dir string, sizes []int, quality int, limits imaging.Limits) (*Generator, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create thumbnail directory: %v", err)
+	}
+	if quality <= 0 || quality > 100 {
+		quality = DefaultQuality
+	}
+	return &Generator{blobs: blobs, dir: dir, sizes: sizes, quality: quality, limits: limits}, nil
+}
+
+// Sizes returns the configured sizes, smallest first
+func (g *Generator) Sizes() []int {
+	return g.sizes
+}
+
+// HasSize reports whether size is configured
+func (g *Generator) HasSize(size int) bool {
+	for _, s := range g.sizes {
+		if s == size {
+			return true
+		}
+	}
+	return false
+}
+
+// path is where the thumbnail of key at size lives
+func (g *Generator) path(key string, size int) string {
+	return filepath.Join(g.dir, key[:2], key+"_"+strconv.Itoa(size)+".jpg")
+}
+
+// Open returns the stored thumbnail of key at size, or os.ErrNotExist
+func (g *Generator) Open(key string, size int) (*os.File, error) {
+	if !blobstore.ValidKey(key) {
+		return nil, os.ErrNotExist
+	}
+	return os.Open(g.path(key, size))
+}
+
+// Generate renders every configured size of key that is missing. The
+// original is decoded once for all of them.
+func (g *Generator) Generate(ctx context.Context, key string) error {
+	if !blobstore.ValidKey(key) {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+
+	var missing []int
+	for _, size := range g.sizes {
+		if _, err := os.Stat(g.path(key, size)); os.IsNotExist(err) {
+			missing = append(missing, size)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	src, err := g.decode(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(g.path(key, 0)), 0755); err != nil {
+		return fmt.Errorf("failed to create thumbnail directory: %v", err)
+	}
+	for _, size := range missing {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, Fit(src, size), &jpeg.Options{Quality: g.quality}); err != nil {
+			return fmt.Errorf("failed to encode thumbnail: %v", err)
+		}
+		if err := fsutil.WriteFileAtomic(g.path(key, size), buf.Bytes(), 0644); err != nil {
+			return fmt.Errorf("failed to save thumbnail: %v", err)
+		}
+	}
+	return nil
+}
+
+// decode reads and decodes the original, refusing oversized canvases
+func (g *Generator) decode(ctx context.Context, key string) (image.Image, error) {
+	blob, err := g.blobs.Open(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer blob.Close()
+
+	data, err := io.ReadAll(blob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %v", err)
+	}
+	if !Supported(imaging.MimeType(data)) {
+		return nil, ErrUnsupported
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errUndecodable, err)
+	}
+	if g.limits.MaxPixels > 0 && int64(config.Width)*int64(config.Height) > g.limits.MaxPixels {
+		return nil, imaging.ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errUndecodable, err)
+	}
+	return img, nil
+}
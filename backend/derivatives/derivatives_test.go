@@ -0,0 +1,222 @@
+package derivatives
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"licenz-backend/blobstore"
+	"licenz-backend/imaging"
+)
+
+func TestFit(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 400, 200))
+	for y := 0; y < 200; y++ {
+		for x := 0; x < 400; x++ {
+			if x%2 == 0 {
+				src.Set(x, y, color.RGBA{255, 0, 0, 255})
+			} else {
+				src.Set(x, y, color.RGBA{0, 0, 255, 255})
+			}
+		}
+	}
+
+	dst := Fit(src, 100)
+	if got := dst.Bounds().Size(); got != image.Pt(100, 50) {
+		t.Fatalf("size = %v, want 100x50", got)
+	}
+	// Alternating columns average out to purple
+	if c := dst.RGBAAt(10, 10); c.R < 120 || c.R > 135 || c.B < 120 || c.B > 135 {
+		t.Fatalf("pixel = %v, want an even mix", c)
+	}
+
+	// Small images keep their size; transparency becomes white
+	if c := Fit(image.NewNRGBA(image.Rect(0, 0, 10, 20)), 100).RGBAAt(5, 5); c != (color.RGBA{255, 255, 255, 255}) {
+		t.Fatalf("transparent pixel = %v, want white", c)
+	}
+}
+
+func TestGeneratorAndPool(t *testing.T) {
+	blobs, err := blobstore.NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFilesystemStore: %v", err)
+	}
+	var buf bytes.Buffer
+	png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 600, 300)))
+	info, err := blobs.Put(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	gen, err := NewGenerator(blobs, t.TempDir(), []int{64, 256}, 0, imaging.DefaultLimits)
+	if err != nil {
+		t.Fatalf("NewGenerator: %v", err)
+	}
+	if _, err := gen.Open(info.Key, 64); !os.IsNotExist(err) {
+		t.Fatalf("thumbnail exists before generation: %v", err)
+	}
+
+	pool := NewPool(gen, 2, 4)
+	pool.Enqueue(info.Key)
+	pool.Close()
+
+	for size, want := range map[int]image.Point{64: {64, 32}, 256: {256, 128}} {
+		f, err := gen.Open(info.Key, size)
+		if err != nil {
+			t.Fatalf("Open(%d): %v", size, err)
+		}
+		config, err := jpeg.DecodeConfig(f)
+		f.Close()
+		if err != nil || (image.Point{config.Width, config.Height}) != want {
+			t.Fatalf("thumbnail %d = %dx%d, %v; want %v", size, config.Width, config.Height, err, want)
+		}
+	}
+
+	if pool.Enqueue(info.Key) {
+		t.Fatal("closed pool accepted work")
+	}
+
+	// Formats the standard library cannot decode are skipped
+	webp, _ := blobs.Put(context.Background(), bytes.NewReader([]byte("RIFF\x00\x00\x00\x00WEBPVP8L")))
+	if err := gen.Generate(context.Background(), webp.Key); err != ErrUnsupported {
+		t.Fatalf("Generate(webp) = %v, want ErrUnsupported", err)
+	}
+
+	// The pixel limit is the one uploads were accepted under
+	small, err := NewGenerator(blobs, t.TempDir(), []int{64}, 0, imaging.Limits{MaxPixels: 600*300 - 1})
+	if err != nil {
+		t.Fatalf("NewGenerator: %v", err)
+	}
+	if err := small.Generate(context.Background(), info.Key); !errors.Is(err, imaging.ErrTooManyPixels) {
+		t.Fatalf("Generate over the configured limit = %v, want ErrTooManyPixels", err)
+	}
+}
+
+func TestPoolRequest(t *testing.T) {
+	blobs, err := blobstore.NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFilesystemStore: %v", err)
+	}
+	gen, err := NewGenerator(blobs, t.TempDir(), []int{64}, 0, imaging.DefaultLimits)
+	if err != nil {
+		t.Fatalf("NewGenerator: %v", err)
+	}
+	pool := NewPool(gen, 1, 1)
+	defer pool.Close()
+
+	// A key that cannot be rendered is queued once, then no longer offered
+	missing := "0000000000000000000000000000000000000000000000000000000000000000"
+	if !pool.Request(missing) {
+		t.Fatal("Request of a new key = false")
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for pool.Request(missing) {
+		if time.Now().After(deadline) {
+			t.Fatal("failed key is still offered for rendering")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// flakyStore fails to open blobs while down is set
+type flakyStore struct {
+	blobstore.Store
+	down atomic.Bool
+}
+
+func (s *flakyStore) Open(ctx context.Context, key string) (blobstore.Blob, error) {
+	if s.down.Load() {
+		return nil, errors.New("disk unavailable")
+	}
+	return s.Store.Open(ctx, key)
+}
+
+func TestPoolRetriesTransientFailures(t *testing.T) {
+	fs, err := blobstore.NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFilesystemStore: %v", err)
+	}
+	blobs := &flakyStore{Store: fs}
+	var buf bytes.Buffer
+	png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 100, 100)))
+	info, _ := blobs.Put(context.Background(), &buf)
+
+	gen, err := NewGenerator(blobs, t.TempDir(), []int{64}, 0, imaging.DefaultLimits)
+	if err != nil {
+		t.Fatalf("NewGenerator: %v", err)
+	}
+	var mutex sync.Mutex
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	advance := func(d time.Duration) {
+		mutex.Lock()
+		now = now.Add(d)
+		mutex.Unlock()
+	}
+	pool := NewPool(gen, 1, 1)
+	defer pool.Close()
+	pool.now = func() time.Time {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return now
+	}
+
+	// waitFailed waits until the pool stops offering key after a failure
+	waitFailed := func() {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for pool.Request(info.Key) {
+			if time.Now().After(deadline) {
+				t.Fatal("failed key is still offered for rendering")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	// Each failure doubles the wait before the next attempt
+	blobs.down.Store(true)
+	waitFailed()
+	advance(minRetryDelay)
+	waitFailed()
+	advance(2*minRetryDelay - time.Second)
+	if pool.Request(info.Key) {
+		t.Fatal("retried before the backoff ended")
+	}
+
+	blobs.down.Store(false)
+	advance(time.Second)
+	if !pool.Request(info.Key) {
+		t.Fatal("transient failure was not retried after the backoff")
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if f, err := gen.Open(info.Key, 64); err == nil {
+			f.Close()
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("thumbnail not rendered on retry")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestParseSizes(t *testing.T) {
+	sizes, err := ParseSizes(" 1024, 256 ")
+	if err != nil || len(sizes) != 2 || sizes[0] != 256 || sizes[1] != 1024 {
+		t.Fatalf("ParseSizes = %v, %v", sizes, err)
+	}
+	for _, spec := range []string{"", "abc", "8", "99999"} {
+		if _, err := ParseSizes(spec); err == nil {
+			t.Errorf("ParseSizes(%q) accepted", spec)
+		}
+	}
+}
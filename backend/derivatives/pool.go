@@ -0,0 +1,168 @@
+package derivatives
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"licenz-backend/blobstore"
+	"licenz-backend/imaging"
+)
+
+// Rendering that failed for a reason that may pass, such as a full disk, is
+// retried after a delay that doubles with each failure, up to maxRetryDelay
+const (
+	minRetryDelay = time.Minute
+	maxRetryDelay = time.Hour
+)
+
+// failure records why a key is not rendered again yet
+type failure struct {
+	permanent bool      // the original can never be rendered
+	attempts  int       // consecutive failures
+	retryAt   time.Time // when a transient failure may be retried
+}
+
+// Pool renders thumbnails in the background so uploads do not wait for
+// them. Each blob key is queued at most once at a time.
+type Pool struct {
+	gen  *Generator
+	jobs chan string
+	wg   sync.WaitGroup
+
+	mutex   sync.Mutex
+	pending map[string]bool
+	failed  map[string]*failure
+	closed  bool
+	now     func() time.Time
+}
+
+// NewPool starts workers goroutines consuming a queue of up to queue keys
+func NewPool(gen *Generator, workers, queue int) *Pool {
+	if workers < 1 {
+		workers = 1
+	}
+	p := &Pool{
+		gen:     gen,
+		jobs:    make(chan string, queue),
+		pending: make(map[string]bool),
+		failed:  make(map[string]*failure),
+		now:     time.Now,
+	}
+	for i := 0; i < workers; i++ {
+		p.wg.Add(1)
+		go p.work()
+	}
+	return p
+}
+
+// Generator returns the generator the pool runs
+func (p *Pool) Generator() *Generator {
+	return p.gen
+}
+
+// Enqueue schedules thumbnails for key. It never blocks: when the queue is
+// full the key is dropped, and the thumbnail endpoint requests it again.
+func (p *Pool) Enqueue(key string) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.pending[key] {
+		return false
+	}
+	return p.enqueueLocked(key)
+}
+
+// Request schedules thumbnails for a key the thumbnail endpoint found none
+// for. It reports whether they are on their way: false when the queue is
+// full, the pool is closed, or rendering the key failed before and may not
+// be retried yet.
+func (p *Pool) Request(key string) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.pending[key] {
+		return true
+	}
+	if f := p.failed[key]; f != nil && (f.permanent || p.now().Before(f.retryAt)) {
+		return false
+	}
+	return p.enqueueLocked(key)
+}
+
+// enqueueLocked queues key without blocking; p.mutex must be held
+func (p *Pool) enqueueLocked(key string) bool {
+	if p.closed {
+		return false
+	}
+	select {
+	case p.jobs <- key:
+		p.pending[key] = true
+		return true
+	default:
+		fmt.Printf("⚠️ Warning: Thumbnail queue full, skipping %s\n", key)
+		return false
+	}
+}
+
+// Close stops accepting work and waits for queued keys to finish
+func (p *Pool) Close() {
+	p.mutex.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.jobs)
+	}
+	p.mutex.Unlock()
+	p.wg.Wait()
+}
+
+// work renders queued keys until the queue is closed
+func (p *Pool) work() {
+	defer p.wg.Done()
+	for key := range p.jobs {
+		err := p.gen.Generate(context.Background(), key)
+		if err != nil && err != ErrUnsupported {
+			fmt.Printf("⚠️ Warning: Failed to generate thumbnails for %s: %v\n", key, err)
+		}
+
+		p.mutex.Lock()
+		delete(p.pending, key)
+		p.recordLocked(key, err)
+		p.mutex.Unlock()
+	}
+}
+
+// recordLocked notes the outcome of rendering key; p.mutex must be held
+func (p *Pool) recordLocked(key string, err error) {
+	if err == nil {
+		delete(p.failed, key)
+		return
+	}
+	f := p.failed[key]
+	if f == nil {
+		f = &failure{}
+		p.failed[key] = f
+	}
+	f.permanent = permanent(key, err)
+	f.attempts++
+
+	delay := minRetryDelay
+	for i := 1; i < f.attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	f.retryAt = p.now().Add(delay)
+}
+
+// permanent reports whether err means key can never be rendered
+func permanent(key string, err error) bool {
+	return !blobstore.ValidKey(key) ||
+		errors.Is(err, ErrUnsupported) ||
+		errors.Is(err, errUndecodable) ||
+		errors.Is(err, imaging.ErrTooManyPixels) ||
+		errors.Is(err, blobstore.ErrNotFound)
+}
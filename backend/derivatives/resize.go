@@ -0,0 +1,56 @@
+package derivatives
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// Fit scales src down so its longest side is at most size, averaging every
+// source pixel that falls under each destination pixel. Transparent areas
+// are flattened onto white, since JPEG has no alpha. Images that already
+// fit are only flattened.
+func Fit(src image.Image, size int) *image.RGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if w > size || h > size {
+		if w >= h {
+			dw, dh = size, max(1, h*size/w)
+		} else {
+			dw, dh = max(1, w*size/h), size
+		}
+	}
+
+	// Flatten onto white first so the averaging works on opaque pixels
+	flat := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(flat, flat.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), src, b.Min, draw.Over)
+	if dw == w && dh == h {
+		return flat
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		y0, y1 := y*h/dh, max((y+1)*h/dh, y*h/dh+1)
+		for x := 0; x < dw; x++ {
+			x0, x1 := x*w/dw, max((x+1)*w/dw, x*w/dw+1)
+
+			var r, g, bl, n int
+			for sy := y0; sy < y1; sy++ {
+				row := flat.Pix[sy*flat.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4:]
+					r += int(p[0])
+					g += int(p[1])
+					bl += int(p[2])
+					n++
+				}
+			}
+
+			d := dst.Pix[y*dst.Stride+x*4:]
+			d[0], d[1], d[2], d[3] = uint8(r/n), uint8(g/n), uint8(bl/n), 0xff
+		}
+	}
+	return dst
+}
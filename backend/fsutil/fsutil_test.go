@@ -0,0 +1,31 @@
+package fsutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "state.json")
+
+	for _, data := range []string{`{"v":1}`, `{"v":2}`} {
+		if err := WriteFileAtomic(path, []byte(data), 0600); err != nil {
+			t.Fatalf("WriteFileAtomic: %v", err)
+		}
+		if got, err := os.ReadFile(path); err != nil || string(got) != data {
+			t.Fatalf("file holds %q, %v; want %q", got, err, data)
+		}
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0600 {
+		t.Errorf("mode = %v, want 0600", info.Mode().Perm())
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("directory holds %d entries, want only the file", len(entries))
+	}
+
+	if err := WriteFileAtomic(filepath.Join(dir, "missing", "state.json"), []byte("x"), 0600); err == nil {
+		t.Fatal("write into a missing directory succeeded")
+	}
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"licenz-backend/auth"
+	"licenz-backend/database"
+	"licenz-backend/models"
+)
+
+// AdminHandler serves the /api/admin routes
+type AdminHandler struct {
+	store database.ContentStore
+	users *auth.UserStore
+}
+
+// NewAdminHandler creates an admin handler
+func NewAdminHandler(store database.ContentStore, users *auth.UserStore) *AdminHandler {
+	return &AdminHandler{store: store, users: users}
+}
+
+// ListUsers handles GET /api/admin/users
+func (h *AdminHandler) ListUsers(c *gin.Context) {
+	if !authorize(c, auth.ActionManageUsers, nil) {
+		return
+	}
+
+	users := h.users.List()
+	c.JSON(http.StatusOK, gin.H{
+		"success":      true,
+		"data":         users,
+		"total":        len(users),
+		"default_role": auth.DefaultRole,
+	})
+}
+
+// SetUserRole handles PUT /api/admin/users/:subject/role
+func (h *AdminHandler) SetUserRole(c *gin.Context) {
+	if !authorize(c, auth.ActionManageUsers, nil) {
+		return
+	}
+
+	var req models.SetRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   "Invalid request data: " + err.Error(),
+		})
+		return
+	}
+	role, err := auth.ParseRole(req.Role)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	identity, _ := auth.FromContext(c.Request.Context())
+	user, err := h.users.SetRole(c.Param("subject"), role, identity.Subject)
+	if errors.Is(err, auth.ErrBootstrapAdmin) {
+		c.JSON(http.StatusConflict, models.ContentResponse{
+			Success: false,
+			Error:   "Failed to set role: " + err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ContentResponse{
+			Success: false,
+			Error:   "Failed to set role: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Role updated successfully",
+		"data":    user,
+	})
+}
+
+// Backup handles POST /api/admin/backup
+func (h *AdminHandler) Backup(c *gin.Context) {
+	if !authorize(c, auth.ActionBackup, nil) {
+		return
+	}
+
+	if err := h.store.Backup(); err != nil {
+		c.JSON(http.StatusInternalServerError, models.ContentResponse{
+			Success: false,
+			Error:   "Failed to back up content: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ContentResponse{
+		Success: true,
+		Message: "Backup created successfully",
+	})
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"licenz-backend/auth"
+	"licenz-backend/database"
+)
+
+// asAdmin runs handler signed in as the admin testOwner
+func asAdmin(handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := auth.WithIdentity(c.Request.Context(), &auth.Identity{Subject: testOwner, Method: "test", Role: auth.RoleAdmin})
+		c.Request = c.Request.WithContext(ctx)
+		handler(c)
+	}
+}
+
+func TestSetUserRole(t *testing.T) {
+	users, err := auth.NewUserStore(filepath.Join(t.TempDir(), "users.json"), []string{strings.ToLower(testOwner)})
+	if err != nil {
+		t.Fatalf("NewUserStore: %v", err)
+	}
+	h := NewAdminHandler(database.NewMemoryDB(), users)
+	setRole := func(subject, body string) (int, string) {
+		w := serve(asAdmin(h.SetUserRole), "/admin/users/:subject/role", "PUT", "/admin/users/"+subject+"/role", "", strings.NewReader(body), nil)
+		return w.Code, decodeResponse(w).Error
+	}
+
+	if code, msg := setRole("alice", `{"role":"moderator"}`); code != http.StatusOK {
+		t.Fatalf("SetUserRole = %d %s", code, msg)
+	}
+	if role := users.Role("alice"); role != auth.RoleModerator {
+		t.Fatalf("role = %s after SetUserRole", role)
+	}
+	if code, _ := setRole("alice", `{"role":"owner"}`); code != http.StatusBadRequest {
+		t.Errorf("unknown role = %d, want 400", code)
+	}
+	// ADMIN_SUBJECTS lists testOwner in lowercase
+	if code, _ := setRole(testOwner, `{"role":"viewer"}`); code != http.StatusConflict {
+		t.Errorf("demoting a bootstrap admin = %d, want 409", code)
+	}
+}
+
+func TestSetUserRoleSaveFailure(t *testing.T) {
+	parent := filepath.Join(t.TempDir(), "users")
+	users, err := auth.NewUserStore(filepath.Join(parent, "users.json"), nil)
+	if err != nil {
+		t.Fatalf("NewUserStore: %v", err)
+	}
+	// The users file cannot be written beneath a regular file
+	if err := os.WriteFile(parent, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	h := NewAdminHandler(database.NewMemoryDB(), users)
+
+	w := serve(asAdmin(h.SetUserRole), "/admin/users/:subject/role", "PUT", "/admin/users/alice/role", "", strings.NewReader(`{"role":"viewer"}`), nil)
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("SetUserRole with an unwritable store = %d, want 500", w.Code)
+	}
+	if role := users.Role("alice"); role != auth.DefaultRole {
+		t.Fatalf("role = %s after a failed save", role)
+	}
+}
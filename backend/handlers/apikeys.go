@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"licenz-backend/auth"
+	"licenz-backend/models"
+)
+
+// APIKeyHandler serves the /api/keys routes. Callers manage their own keys.
+type APIKeyHandler struct {
+	keys *auth.APIKeyStore
+}
+
+// NewAPIKeyHandler creates an API key handler backed by keys
+func NewAPIKeyHandler(keys *auth.APIKeyStore) *APIKeyHandler {
+	return &APIKeyHandler{keys: keys}
+}
+
+// CreateKey handles POST /api/keys
+//
+// A key can only be granted scopes the caller holds, so a key can never
+// create a more powerful one. The token is returned once and never again.
+func (h *APIKeyHandler) CreateKey(c *gin.Context) {
+	identity, _ := auth.FromContext(c.Request.Context())
+
+	var req models.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.APIKeyResponse{
+			Success: false,
+			Error:   "Invalid request data: " + err.Error(),
+		})
+		return
+	}
+
+	for _, scope := range req.Scopes {
+		if !identity.HasScope(scope) {
+			c.JSON(http.StatusForbidden, models.APIKeyResponse{
+				Success: false,
+				Error:   "Cannot grant the " + scope + " scope",
+			})
+			return
+		}
+	}
+
+	key, token, err := h.keys.Create(identity.Subject, strings.TrimSpace(req.Name), req.Scopes)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIKeyResponse{
+			Success: false,
+			Error:   "Failed to create api key: " + err.Error(),
+		})
+		return
+	}
+
+	info := apiKeyInfo(key)
+	c.JSON(http.StatusCreated, models.APIKeyResponse{
+		Success: true,
+		Message: "API key created; store the token now, it will not be shown again",
+		Data:    &info,
+		Token:   token,
+	})
+}
+
+// ListKeys handles GET /api/keys
+func (h *APIKeyHandler) ListKeys(c *gin.Context) {
+	identity, _ := auth.FromContext(c.Request.Context())
+
+	keys := h.keys.List(identity.Subject)
+	infos := make([]models.APIKeyInfo, len(keys))
+	for i, key := range keys {
+		infos[i] = apiKeyInfo(key)
+	}
+
+	c.JSON(http.StatusOK, models.APIKeyListResponse{
+		Success: true,
+		Data:    infos,
+		Total:   len(infos),
+	})
+}
+
+// RevokeKey handles DELETE /api/keys/:id
+func (h *APIKeyHandler) RevokeKey(c *gin.Context) {
+	identity, _ := auth.FromContext(c.Request.Context())
+
+	key, err := h.keys.Revoke(identity.Subject, c.Param("id"))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, auth.ErrKeyNotFound) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, models.APIKeyResponse{
+			Success: false,
+			Error:   "Failed to revoke api key: " + err.Error(),
+		})
+		return
+	}
+
+	info := apiKeyInfo(key)
+	c.JSON(http.StatusOK, models.APIKeyResponse{
+		Success: true,
+		Message: "API key revoked",
+		Data:    &info,
+	})
+}
+
+// apiKeyInfo is the public view of a stored key
+func apiKeyInfo(key auth.APIKey) models.APIKeyInfo {
+	return models.APIKeyInfo{
+		ID:         key.ID,
+		Name:       key.Name,
+		Owner:      key.Owner,
+		Scopes:     key.Scopes,
+		CreatedAt:  key.CreatedAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+	}
+}
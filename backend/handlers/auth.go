@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"licenz-backend/auth"
+	"licenz-backend/models"
+)
+
+// AuthHandler serves the /api/auth wallet login routes
+type AuthHandler struct {
+	nonces   *auth.NonceStore
+	sessions *auth.Sessions
+	policy   auth.SIWEPolicy
+}
+
+// NewAuthHandler creates an auth handler issuing sessions for messages
+// that satisfy policy
+func NewAuthHandler(nonces *auth.NonceStore, sessions *auth.Sessions, policy auth.SIWEPolicy) *AuthHandler {
+	return &AuthHandler{nonces: nonces, sessions: sessions, policy: policy}
+}
+
+// Available aborts with 503 when no sign-in domain is configured
+func (h *AuthHandler) Available(c *gin.Context) {
+	if h.policy.Domain == "" {
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, models.SessionResponse{
+			Success: false,
+			Error:   "Wallet sign-in is not configured",
+		})
+	}
+}
+
+// Nonce handles GET /api/auth/nonce
+func (h *AuthHandler) Nonce(c *gin.Context) {
+	nonce, err := h.nonces.Issue()
+	if errors.Is(err, auth.ErrTooManyNonces) {
+		c.Header("Retry-After", "60")
+		c.JSON(http.StatusServiceUnavailable, models.NonceResponse{
+			Success: false,
+			Error:   "Too many sign-ins in progress, try again later",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.NonceResponse{
+			Success: false,
+			Error:   "Failed to generate nonce: " + err.Error(),
+		})
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, models.NonceResponse{
+		Success: true,
+		Nonce:   nonce,
+	})
+}
+
+// Verify handles POST /api/auth/verify
+//
+// The body carries an EIP-4361 message built around a nonce from Nonce and
+// its personal_sign signature. On success the response holds a session
+// token to send as "Authorization: Bearer <token>".
+func (h *AuthHandler) Verify(c *gin.Context) {
+	var req models.SIWEVerifyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.SessionResponse{
+			Success: false,
+			Error:   "Invalid request data: " + err.Error(),
+		})
+		return
+	}
+
+	msg, err := auth.ParseSIWEMessage(req.Message)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.SessionResponse{
+			Success: false,
+			Error:   "Invalid sign-in message: " + err.Error(),
+		})
+		return
+	}
+
+	if err := h.policy.Validate(msg, time.Now()); err != nil {
+		h.reject(c, err.Error())
+		return
+	}
+	if err := auth.VerifySIWESignature(req.Message, msg, req.Signature); err != nil {
+		h.reject(c, err.Error())
+		return
+	}
+	// Consume the nonce last so a bad signature cannot burn someone else's
+	if !h.nonces.Consume(msg.Nonce) {
+		h.reject(c, "nonce is unknown, expired or already used")
+		return
+	}
+
+	address := msg.Address.Hex()
+	token, expires, err := h.sessions.Issue(address)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.SessionResponse{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SessionResponse{
+		Success:   true,
+		Message:   "Signed in successfully",
+		Token:     token,
+		ExpiresAt: expires,
+		Address:   address,
+	})
+}
+
+// Me handles GET /api/auth/me
+func (h *AuthHandler) Me(c *gin.Context) {
+	identity, _ := auth.FromContext(c.Request.Context())
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"subject": identity.Subject,
+		"method":  identity.Method,
+	})
+}
+
+// reject answers a sign-in attempt that failed verification
+func (h *AuthHandler) reject(c *gin.Context, reason string) {
+	c.JSON(http.StatusUnauthorized, models.SessionResponse{
+		Success: false,
+		Error:   "Sign-in failed: " + reason,
+	})
+}
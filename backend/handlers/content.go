@@ -1,33 +1,91 @@
 package handlers
 
 import (
-	"encoding/base64"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/google/uuid"
+	"licenz-backend/auth"
+	"licenz-backend/blobstore"
+	"licenz-backend/contenthash"
 	"licenz-backend/database"
+	"licenz-backend/derivatives"
+	"licenz-backend/imaging"
 	"licenz-backend/models"
+	"licenz-backend/ratelimit"
+	"licenz-backend/search"
+	"licenz-backend/uploads"
 )
 
-// Global database instance
-var db *database.SimplePersistentDB
+// ContentHandler serves the /api/content routes on top of a ContentStore,
+// keeping image bytes in a separate blob store and answering searches from
+// a full-text index. Uploads count against each caller's daily storage quota.
+type ContentHandler struct {
+	store  database.ContentStore
+	blobs  blobstore.Store
+	index  *search.Index
+	quotas *ratelimit.Quotas
+	limits imaging.Limits
+	thumbs *derivatives.Pool
+	stage  string // directory for images being received
 
-// Initialize database
-func init() {
-	fmt.Println("🔧 Initializing persistent database...")
-	db = database.NewSimplePersistentDB()
-	fmt.Printf("✅ Database initialized: %s\n", db.GetFilePath())
+	// createMutex makes the duplicate-hash check and the insert atomic; it
+	// is not held while image bytes are written
+	createMutex sync.Mutex
+}
+
+// NewContentHandler creates a content handler backed by the given stores.
+// The index must be kept in step with store, e.g. via search.IndexedStore.
+// Uploaded images are staged in stageDir, checked against limits and
+// thumbnailed by thumbs.
+func NewContentHandler(store database.ContentStore, blobs blobstore.Store, index *search.Index, quotas *ratelimit.Quotas, limits imaging.Limits, thumbs *derivatives.Pool, stageDir string) *ContentHandler {
+	return &ContentHandler{store: store, blobs: blobs, index: index, quotas: quotas, limits: limits, thumbs: thumbs, stage: stageDir}
 }
 
 // CreateContent handles POST /api/content
-func CreateContent(c *gin.Context) {
+//
+// The content is owned by the authenticated caller; any UserID in the
+// request body is ignored. The image arrives either inline, as JSON whose
+// ImageData is raw base64 or a data URL, or as multipart/form-data with a
+// "metadata" part holding the same JSON minus ImageData and an "image" part
+// holding the file; see createFromMultipart.
+func (h *ContentHandler) CreateContent(c *gin.Context) {
+	if !authorize(c, auth.ActionCreateContent, nil) {
+		return
+	}
+
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		h.createFromMultipart(c)
+		return
+	}
+
+	// Base64 inflates the image by a third; leave room for the other fields
+	if h.limits.MaxBytes > 0 {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.limits.MaxBytes*4/3+64<<10)
+	}
+
 	var req models.CreateContentRequest
-	
+
 	if err := c.ShouldBindJSON(&req); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, models.ContentResponse{
+				Success: false,
+				Error:   imaging.ErrTooLarge.Error(),
+			})
+			return
+		}
 		c.JSON(http.StatusBadRequest, models.ContentResponse{
 			Success: false,
 			Error:   "Invalid request data: " + err.Error(),
@@ -35,86 +93,331 @@ func CreateContent(c *gin.Context) {
 		return
 	}
 
+	imageBytes, err := imaging.DecodeData(req.ImageData, h.limits.MaxBytes)
+	if err != nil {
+		c.JSON(imageErrorStatus(err), models.ContentResponse{
+			Success: false,
+			Error:   "Invalid ImageData: " + err.Error(),
+		})
+		return
+	}
+
+	file, err := uploads.Stage(h.stage, bytes.NewReader(imageBytes), h.limits.MaxBytes)
+	if err != nil {
+		c.JSON(imageErrorStatus(err), models.ContentResponse{
+			Success: false,
+			Error:   "Failed to stage image: " + err.Error(),
+		})
+		return
+	}
+	defer file.Remove()
+
+	h.createFromFile(c, req.ContentMetadata, file, false)
+}
+
+// createFromMultipart streams the "image" part of a multipart upload to
+// disk, so large images never sit in memory, and reads the "metadata" part
+// as ContentMetadata JSON. Parts may come in either order.
+func (h *ContentHandler) createFromMultipart(c *gin.Context) {
+	if h.limits.MaxBytes > 0 {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.limits.MaxBytes+maxMetadataBytes+64<<10)
+	}
+
+	reader, err := c.Request.MultipartReader()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   "Invalid multipart body: " + err.Error(),
+		})
+		return
+	}
+
+	var meta *models.ContentMetadata
+	var file *uploads.File
+	defer func() {
+		if file != nil {
+			file.Remove()
+		}
+	}()
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			c.JSON(http.StatusBadRequest, models.ContentResponse{
+				Success: false,
+				Error:   "Invalid multipart body: " + err.Error(),
+			})
+			return
+		}
+
+		switch part.FormName() {
+		case "metadata":
+			data, err := io.ReadAll(io.LimitReader(part, maxMetadataBytes))
+			meta = &models.ContentMetadata{}
+			if err == nil {
+				err = binding.JSON.BindBody(data, meta)
+			}
+			if err != nil {
+				c.JSON(http.StatusBadRequest, models.ContentResponse{
+					Success: false,
+					Error:   "Invalid metadata: " + err.Error(),
+				})
+				return
+			}
+		case "image":
+			if file != nil {
+				c.JSON(http.StatusBadRequest, models.ContentResponse{
+					Success: false,
+					Error:   "Only one image part is allowed",
+				})
+				return
+			}
+			if file, err = uploads.Stage(h.stage, part, h.limits.MaxBytes); err != nil {
+				c.JSON(imageErrorStatus(err), models.ContentResponse{
+					Success: false,
+					Error:   "Invalid image: " + err.Error(),
+				})
+				return
+			}
+		}
+		part.Close()
+	}
+
+	if meta == nil || file == nil {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   "Multipart uploads need a metadata part and an image part",
+		})
+		return
+	}
+
+	h.createFromFile(c, *meta, file, false)
+}
+
+// rejectDuplicate responds with 409 when an image with the SHA-256 hash is
+// already registered, naming the existing item only if the caller may read
+// it. It reports whether it responded.
+func (h *ContentHandler) rejectDuplicate(c *gin.Context, hash string) bool {
+	existing, err := findByHash(h.store, hash)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ContentResponse{
+			Success: false,
+			Error:   "Failed to check for duplicates: " + err.Error(),
+		})
+		return true
+	}
+	if existing == nil {
+		return false
+	}
+	// Hidden content is not found by those who may not see it, so its ID
+	// is not given away either
+	if existing.Hidden && !auth.Allowed(c.Request.Context(), auth.ActionViewHidden, existing) {
+		c.JSON(http.StatusConflict, models.ContentResponse{
+			Success: false,
+			Error:   "This image is already registered",
+		})
+		return true
+	}
+	c.Header("Location", "/api/content/"+existing.ID)
+	c.JSON(http.StatusConflict, gin.H{
+		"success":     false,
+		"error":       "This image is already registered",
+		"existing_id": existing.ID,
+	})
+	return true
+}
+
+// createFromFile validates an image received by any upload path and
+// registers it. ContentHash must be the SHA-256 or keccak256 of the image
+// bytes, and each image can be registered only once. The real format and
+// size are detected, and width and height, if given, must match. The file
+// is charged to the caller's storage quota unless reserved says that was
+// done when it was received. It reports whether the content was created.
+func (h *ContentHandler) createFromFile(c *gin.Context, meta models.ContentMetadata, file *uploads.File, reserved bool) bool {
+	identity, _ := auth.FromContext(c.Request.Context())
+
+	image, err := inspectFile(file, h.limits)
+	if err == nil {
+		err = checkDeclaredSize(&meta, image)
+	}
+	if err != nil {
+		c.JSON(imageErrorStatus(err), models.ContentResponse{
+			Success: false,
+			Error:   "Invalid image: " + err.Error(),
+		})
+		return false
+	}
+
+	// The hash is the proof of authorship, so it must describe these bytes
+	hashes := file.Hashes
+	if _, err := hashes.Verify(meta.ContentHash); err != nil {
+		status := http.StatusBadRequest
+		if err == contenthash.ErrMismatch {
+			status = http.StatusUnprocessableEntity
+		}
+		c.JSON(status, models.ContentResponse{
+			Success: false,
+			Error:   "Invalid ContentHash: " + err.Error(),
+		})
+		return false
+	}
+
+	// Turn away a registered image before storing anything
+	if h.rejectDuplicate(c, hashes.SHA256) {
+		return false
+	}
+
+	// Charge the upload to the caller's storage quota, refunding it if the
+	// content is not saved
+	saved := false
+	if !reserved {
+		quotaKey := ratelimit.UserKey(c)
+		d, err := h.quotas.ReserveStorage(quotaKey, file.Size)
+		if err != nil {
+			ratelimit.Abort(c, d, "Daily storage quota exceeded")
+			return false
+		}
+		defer func() {
+			if !saved {
+				h.quotas.ReleaseStorage(quotaKey, file.Size, d.Day)
+			}
+		}()
+	}
+
+	// Store the image bytes by content address; identical images share one blob
+	blob, err := putFile(c, h.blobs, file)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ContentResponse{
+			Success: false,
+			Error:   "Failed to store image: " + err.Error(),
+		})
+		return false
+	}
+
 	// Generate unique ID
 	contentID := uuid.New().String()
-	
+
 	// Create content object
 	now := time.Now()
 	content := models.Content{
-		ID:          contentID,
-		Prompt:      req.Prompt,
-		Style:       req.Style,
-		ImageData:   req.ImageData,
-		ContentHash: req.ContentHash,
-		Seed:        req.Seed,
-		CFGScale:    req.CFGScale,
-		Steps:       req.Steps,
-		Height:      req.Height,
-		Width:       req.Width,
-		Model:       req.Model,
-		GeneratedAt: now,
-		CreatedAt:   now,
-		UpdatedAt:   now,
-		UserID:      req.UserID,
-		IsPublic:    true,
-		IsLicensed:  false,
-		NFTMinted:   false,
-	}
-
-	// Store content in persistent database
-	if err := db.CreateContent(content); err != nil {
+		ID:            contentID,
+		Prompt:        meta.Prompt,
+		Style:         meta.Style,
+		ImageURL:      downloadURL(contentID),
+		ImageBlob:     blob.Key,
+		ImageSize:     blob.Size,
+		MimeType:      image.MimeType,
+		ContentHash:   hashes.SHA256,
+		ContentKeccak: hashes.Keccak256,
+		Seed:          meta.Seed,
+		CFGScale:      meta.CFGScale,
+		Steps:         meta.Steps,
+		Height:        image.Height,
+		Width:         image.Width,
+		Model:         meta.Model,
+		GeneratedAt:   now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		UserID:        identity.Subject,
+		IsPublic:      true,
+		IsLicensed:    false,
+		NFTMinted:     false,
+		Version:       1,
+	}
+
+	// Store content in the configured database. The blob is written outside
+	// the lock: it is content-addressed, so a racing duplicate's copy is the
+	// same file the registered content already uses.
+	h.createMutex.Lock()
+	if h.rejectDuplicate(c, hashes.SHA256) {
+		h.createMutex.Unlock()
+		return false
+	}
+	err = h.store.CreateContent(content)
+	h.createMutex.Unlock()
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.ContentResponse{
 			Success: false,
 			Error:   "Failed to save content: " + err.Error(),
 		})
-		return
+		return false
 	}
+	saved = true
 
+	// Thumbnails are rendered in the background; until then the thumbnail
+	// endpoint answers 202, or redirects to the original if it cannot queue
+	// them
+	if derivatives.Supported(content.MimeType) {
+		h.thumbs.Enqueue(content.ImageBlob)
+	}
+	h.withThumbnails(&content)
+
+	c.Header("ETag", contentETag(&content))
 	c.JSON(http.StatusCreated, models.ContentResponse{
 		Success: true,
-		Message: "Content created successfully and saved to disk",
+		Message: "Content created successfully",
 		Data:    &content,
 	})
+	return true
 }
 
 // GetAllContent handles GET /api/content
-func GetAllContent(c *gin.Context) {
+//
+// Results can be narrowed with the filters described on parseContentFilter.
+// Pages are ordered by ?sort= (default -created_at, ID tiebreak). Clients
+// should page with the returned next_cursor; ?offset= is kept for older
+// clients and ignored when a cursor is given. ?facets=true adds counts per
+// style, model, license and mint status, license type and creator over every
+// filtered item.
+func (h *ContentHandler) GetAllContent(c *gin.Context) {
 	// Get query parameters
-	limitStr := c.DefaultQuery("limit", "50")
-	offsetStr := c.DefaultQuery("offset", "0")
-	userID := c.Query("user_id")
-	
-	limit, _ := strconv.Atoi(limitStr)
-	offset, _ := strconv.Atoi(offsetStr)
-	
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	if limit <= 0 {
+		limit = 50
+	}
 	if limit > 100 {
 		limit = 100
 	}
-	
-	// Get content from persistent database
-	contentList, total, err := db.GetAllContent(limit, offset, userID)
+
+	filter, err := parseContentFilter(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.ContentResponse{
+		c.JSON(http.StatusBadRequest, models.ContentListResponse{
 			Success: false,
-			Error:   "Failed to retrieve content: " + err.Error(),
+			Error:   err.Error(),
 		})
 		return
 	}
 
-	c.JSON(http.StatusOK, models.ContentListResponse{
-		Success: true,
-		Message: "Content retrieved successfully from persistent storage",
-		Data:    contentList,
-		Total:   total,
-	})
-}
+	opts := database.ListOptions{
+		Limit:  limit,
+		Offset: offset,
+		Cursor: c.Query("cursor"),
+		Sort:   c.Query("sort"),
+		Filter: filter,
+	}
+	opts.Filter.ExcludeHidden = !auth.Allowed(c.Request.Context(), auth.ActionViewHidden, nil)
+	if opts.Facets, err = wantFacets(c); err != nil {
+		c.JSON(http.StatusBadRequest, models.ContentListResponse{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+	if err := opts.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, models.ContentListResponse{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
 
-// GetContentByID handles GET /api/content/:id
-func GetContentByID(c *gin.Context) {
-	contentID := c.Param("id")
-	
-	// Get content from persistent database
-	content, err := db.GetContent(contentID)
+	// Get content from the database
+	page, err := h.store.GetAllContent(opts)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.ContentResponse{
 			Success: false,
@@ -122,15 +425,30 @@ func GetContentByID(c *gin.Context) {
 		})
 		return
 	}
-	
-	if content == nil {
-		c.JSON(http.StatusNotFound, models.ContentResponse{
-			Success: false,
-			Error:   "Content not found",
-		})
+
+	for i := range page.Items {
+		h.withThumbnails(&page.Items[i])
+	}
+
+	c.JSON(http.StatusOK, models.ContentListResponse{
+		Success:    true,
+		Message:    "Content retrieved successfully",
+		Data:       page.Items,
+		Total:      page.Total,
+		NextCursor: page.NextCursor,
+		Facets:     page.Facets,
+	})
+}
+
+// GetContentByID handles GET /api/content/:id
+func (h *ContentHandler) GetContentByID(c *gin.Context) {
+	content, ok := h.loadContent(c)
+	if !ok {
 		return
 	}
 
+	h.withThumbnails(content)
+	c.Header("ETag", contentETag(content))
 	c.JSON(http.StatusOK, models.ContentResponse{
 		Success: true,
 		Message: "Content retrieved successfully",
@@ -139,11 +457,15 @@ func GetContentByID(c *gin.Context) {
 }
 
 // DeleteContent handles DELETE /api/content/:id
-func DeleteContent(c *gin.Context) {
-	contentID := c.Param("id")
-	
-	// Delete content from persistent database
-	if err := db.DeleteContent(contentID); err != nil {
+func (h *ContentHandler) DeleteContent(c *gin.Context) {
+	content, ok := h.loadContent(c)
+	if !ok || !authorize(c, auth.ActionDeleteContent, content) {
+		return
+	}
+
+	// Delete content from the database. The image blob is left in place
+	// because other content may reference the same bytes.
+	if err := h.store.DeleteContent(content.ID); err != nil {
 		c.JSON(http.StatusInternalServerError, models.ContentResponse{
 			Success: false,
 			Error:   "Failed to delete content: " + err.Error(),
@@ -153,34 +475,53 @@ func DeleteContent(c *gin.Context) {
 
 	c.JSON(http.StatusOK, models.ContentResponse{
 		Success: true,
-		Message: "Content deleted successfully from persistent storage",
+		Message: "Content deleted successfully",
 	})
 }
 
 // DownloadContent handles GET /api/content/:id/download
-func DownloadContent(c *gin.Context) {
-	contentID := c.Param("id")
-	
-	// Get content from persistent database
-	content, err := db.GetContent(contentID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.ContentResponse{
+func (h *ContentHandler) DownloadContent(c *gin.Context) {
+	content, ok := h.loadContent(c)
+	if !ok {
+		return
+	}
+
+	if content.ImageBlob == "" {
+		h.downloadInlineImage(c, content)
+		return
+	}
+
+	blob, err := h.blobs.Open(c.Request.Context(), content.ImageBlob)
+	if err == blobstore.ErrNotFound {
+		c.JSON(http.StatusNotFound, models.ContentResponse{
 			Success: false,
-			Error:   "Failed to retrieve content: " + err.Error(),
+			Error:   "Image not found",
 		})
 		return
 	}
-	
-	if content == nil {
-		c.JSON(http.StatusNotFound, models.ContentResponse{
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ContentResponse{
 			Success: false,
-			Error:   "Content not found",
+			Error:   "Failed to open image: " + err.Error(),
 		})
 		return
 	}
+	defer blob.Close()
+
+	mimeType := content.MimeType
+	if mimeType == "" {
+		mimeType = "image/png"
+	}
+
+	// The blob key is the SHA-256 of the bytes served, so it makes a strong
+	// validator; ServeContent answers conditional and Range requests
+	serveImage(c, content, blob, mimeType, imageETag(blob.Info().Key))
+}
 
-	// Decode base64 image data
-	imageData, err := base64.StdEncoding.DecodeString(content.ImageData)
+// downloadInlineImage serves records created before images moved to the blob store
+func (h *ContentHandler) downloadInlineImage(c *gin.Context, content *models.Content) {
+	// Decode base64 image data; some legacy records hold a data URL
+	imageData, err := imaging.DecodeData(content.ImageData, 0)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.ContentResponse{
 			Success: false,
@@ -189,25 +530,67 @@ func DownloadContent(c *gin.Context) {
 		return
 	}
 
-	// Set response headers for download
-	c.Header("Content-Disposition", "attachment; filename="+contentID+".png")
-	c.Header("Content-Type", "image/png")
-	c.Header("Content-Length", strconv.Itoa(len(imageData)))
-	
-	// Send the image data
-	c.Data(http.StatusOK, "image/png", imageData)
+	mimeType := imaging.MimeType(imageData)
+	if mimeType == "" {
+		mimeType = "image/png"
+	}
+
+	sum := sha256.Sum256(imageData)
+	serveImage(c, content, bytes.NewReader(imageData), mimeType, imageETag(hex.EncodeToString(sum[:])))
+}
+
+// serveImage writes a content item's image with caching headers. Public
+// content may be cached by shared caches such as a CDN; private or hidden
+// content only by the client, and it must revalidate first.
+func serveImage(c *gin.Context, content *models.Content, image io.ReadSeeker, mimeType, etag string) {
+	c.Header("Content-Type", mimeType)
+	c.Header("Content-Disposition", "attachment; filename="+content.ID+imaging.Extension(mimeType))
+	setImageCaching(c, content, etag)
+	http.ServeContent(c.Writer, c.Request, "", content.UpdatedAt, image)
+}
+
+// setImageCaching sets the ETag and Cache-Control for an image of content
+func setImageCaching(c *gin.Context, content *models.Content, etag string) {
+	c.Header("ETag", etag)
+	if content.IsPublic && !content.Hidden {
+		c.Header("Cache-Control", "public, max-age="+strconv.Itoa(publicImageMaxAge))
+		return
+	}
+	c.Header("Cache-Control", "private, no-cache")
+	c.Header("Vary", "Authorization")
+}
+
+// publicImageMaxAge is how long, in seconds, shared caches may keep a public image
+const publicImageMaxAge = 24 * 60 * 60
+
+// imageETag is the strong entity tag for image bytes with the given SHA-256
+func imageETag(sha256Hex string) string {
+	return `"sha256-` + sha256Hex + `"`
 }
 
 // SearchContent handles GET /api/content/search
-func SearchContent(c *gin.Context) {
+//
+// q supports plain words (stemmed, case-insensitive), "quoted phrases" and
+// prefix* terms; every part must match. Results are ranked by BM25 and
+// carry a score and a highlighted snippet. total counts every match, so
+// limit and offset page through them. ?facets=true adds counts over every
+// match.
+func (h *ContentHandler) SearchContent(c *gin.Context) {
 	query := c.Query("q")
 	limitStr := c.DefaultQuery("limit", "20")
-	
+
 	limit, _ := strconv.Atoi(limitStr)
+	if limit <= 0 {
+		limit = 20
+	}
 	if limit > 50 {
 		limit = 50
 	}
-	
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if offset < 0 {
+		offset = 0
+	}
+
 	if query == "" {
 		c.JSON(http.StatusBadRequest, models.ContentResponse{
 			Success: false,
@@ -215,29 +598,56 @@ func SearchContent(c *gin.Context) {
 		})
 		return
 	}
-	
-	// Search content in persistent database
-	results, err := db.SearchContent(query, limit)
+
+	facets, err := wantFacets(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.ContentResponse{
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
 			Success: false,
-			Error:   "Failed to search content: " + err.Error(),
+			Error:   err.Error(),
 		})
 		return
 	}
 
-	c.JSON(http.StatusOK, models.ContentListResponse{
+	results := h.index.Search(query, search.SearchOptions{
+		Offset:        offset,
+		Limit:         limit,
+		IncludeHidden: auth.Allowed(c.Request.Context(), auth.ActionViewHidden, nil),
+	})
+
+	hits := make([]models.SearchResult, 0, len(results.Hits))
+	for _, hit := range results.Hits {
+		content, err := h.store.GetContent(hit.ID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, models.ContentResponse{
+				Success: false,
+				Error:   "Failed to search content: " + err.Error(),
+			})
+			return
+		}
+		if content == nil {
+			continue
+		}
+		h.withThumbnails(content)
+		hits = append(hits, models.SearchResult{Content: *content, Score: hit.Score, Snippet: hit.Snippet})
+	}
+
+	response := models.SearchResponse{
 		Success: true,
 		Message: "Search completed successfully",
-		Data:    results,
-		Total:   len(results),
-	})
+		Data:    hits,
+		Total:   results.Total,
+	}
+	if facets {
+		response.Facets = h.index.Facets(results.IDs)
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 // GetContentStats handles GET /api/content/stats
-func GetContentStats(c *gin.Context) {
-	// Get content count from persistent database
-	count, err := db.GetContentCount()
+func (h *ContentHandler) GetContentStats(c *gin.Context) {
+	// Get content count from the database
+	count, err := h.store.GetContentCount()
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.ContentResponse{
 			Success: false,
@@ -246,14 +656,169 @@ func GetContentStats(c *gin.Context) {
 		return
 	}
 
+	stats := map[string]interface{}{
+		"total_content": count,
+		"storage_type":  storageType(h.store),
+	}
+	if fs, ok := unwrapStore(h.store).(interface{ GetFilePath() string }); ok {
+		stats["database_file"] = fs.GetFilePath()
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
 		"message": "Content statistics retrieved successfully",
 		"total":   count,
-		"stats": map[string]interface{}{
-			"total_content": count,
-			"storage_type":  "persistent_disk",
-			"database_file": db.GetFilePath(),
-		},
+		"stats":   stats,
+	})
+}
+
+// loadContent fetches the content named by the :id path parameter, writing
+// the error response itself when the lookup fails or finds nothing. Hidden
+// content is reported as not found to callers who may not see it.
+func (h *ContentHandler) loadContent(c *gin.Context) (*models.Content, bool) {
+	return loadContent(c, h.store)
+}
+
+// loadContent is ContentHandler.loadContent for handlers sharing its store
+func loadContent(c *gin.Context, store database.ContentStore) (*models.Content, bool) {
+	content, err := store.GetContent(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ContentResponse{
+			Success: false,
+			Error:   "Failed to retrieve content: " + err.Error(),
+		})
+		return nil, false
+	}
+
+	if content == nil || (content.Hidden && !auth.Allowed(c.Request.Context(), auth.ActionViewHidden, content)) {
+		c.JSON(http.StatusNotFound, models.ContentResponse{
+			Success: false,
+			Error:   "Content not found",
+		})
+		return nil, false
+	}
+
+	return content, true
+}
+
+// authorize asks the policy whether the caller may perform action on
+// content (nil when not about one record), writing a 401 or 403 response
+// itself when they may not
+func authorize(c *gin.Context, action auth.Action, content *models.Content) bool {
+	if auth.Allowed(c.Request.Context(), action, content) {
+		return true
+	}
+
+	if _, ok := auth.FromContext(c.Request.Context()); !ok {
+		c.JSON(http.StatusUnauthorized, models.ContentResponse{
+			Success: false,
+			Error:   "Authentication required",
+		})
+		return false
+	}
+
+	message := "You are not allowed to do this"
+	switch action {
+	case auth.ActionUpdateContent, auth.ActionDeleteContent:
+		message = "You do not own this content"
+	case auth.ActionHideContent:
+		message = "Only moderators can hide content"
+	case auth.ActionCreateContent:
+		message = "Your role cannot create content"
+	}
+	c.JSON(http.StatusForbidden, models.ContentResponse{
+		Success: false,
+		Error:   message,
+	})
+	return false
+}
+
+// maxMetadataBytes bounds the metadata part of a multipart upload
+const maxMetadataBytes = 64 << 10
+
+// inspectFile validates a staged upload
+func inspectFile(file *uploads.File, limits imaging.Limits) (imaging.Info, error) {
+	f, err := file.Open()
+	if err != nil {
+		return imaging.Info{}, err
+	}
+	defer f.Close()
+	return imaging.InspectReader(f, file.Size, limits)
+}
+
+// putFile copies a staged upload into the blob store
+func putFile(c *gin.Context, blobs blobstore.Store, file *uploads.File) (blobstore.Info, error) {
+	f, err := file.Open()
+	if err != nil {
+		return blobstore.Info{}, err
+	}
+	defer f.Close()
+	return blobs.Put(c.Request.Context(), f)
+}
+
+// checkDeclaredSize rejects a request whose width or height disagrees with
+// the decoded image; zero means not declared
+func checkDeclaredSize(req *models.ContentMetadata, image imaging.Info) error {
+	if (req.Width != 0 && req.Width != image.Width) || (req.Height != 0 && req.Height != image.Height) {
+		return fmt.Errorf("declared size %dx%d does not match the %dx%d image", req.Width, req.Height, image.Width, image.Height)
+	}
+	return nil
+}
+
+// imageErrorStatus maps an upload validation error to its HTTP status
+func imageErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, imaging.ErrTooLarge), errors.Is(err, imaging.ErrTooManyPixels):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, imaging.ErrUnsupported):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, imaging.ErrInvalidEncoding):
+		return http.StatusBadRequest
+	default:
+		return http.StatusUnprocessableEntity
+	}
+}
+
+// findByHash returns the content registered with a SHA-256 content hash,
+// hidden or not, or nil
+func findByHash(store database.ContentStore, hash string) (*models.Content, error) {
+	result, err := store.GetAllContent(database.ListOptions{
+		Limit:  1,
+		Filter: database.ContentFilter{ContentHash: hash},
 	})
+	if err != nil || len(result.Items) == 0 {
+		return nil, err
+	}
+	return &result.Items[0], nil
+}
+
+// downloadURL is the API path that serves a content item's image
+func downloadURL(contentID string) string {
+	return "/api/content/" + contentID + "/download"
+}
+
+// storageType reports which backend is serving content, for the stats endpoint
+func storageType(store database.ContentStore) string {
+	switch unwrapStore(store).(type) {
+	case *database.MemoryDB:
+		return "memory"
+	case *database.PersistentDB, *database.SimplePersistentDB:
+		return "persistent_disk"
+	case *database.SQLiteDB:
+		return "sqlite"
+	default:
+		return "custom"
+	}
+}
+
+// unwrapStore peels off wrappers such as search.IndexedStore to reach the
+// backend actually holding the data
+func unwrapStore(store database.ContentStore) database.ContentStore {
+	for {
+		w, ok := store.(interface{ Unwrap() database.ContentStore })
+		if !ok {
+			return store
+		}
+		store = w.Unwrap()
+	}
 }
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"licenz-backend/contenthash"
+	"licenz-backend/database"
+)
+
+// createRequest is a JSON CreateContent body for a small PNG
+func createRequest(t *testing.T) []byte {
+	t.Helper()
+	var img bytes.Buffer
+	if err := png.Encode(&img, image.NewGray(image.Rect(0, 0, 8, 8))); err != nil {
+		t.Fatal(err)
+	}
+	body, err := json.Marshal(map[string]string{
+		"prompt":      "a lighthouse at dusk",
+		"style":       "oil",
+		"ContentHash": contenthash.Compute(img.Bytes()).SHA256,
+		"ImageData":   base64.StdEncoding.EncodeToString(img.Bytes()),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func TestCreateContentRegistersAnImageOnce(t *testing.T) {
+	h := newTestContent(t)
+	body := createRequest(t)
+
+	// Concurrent uploads of one image register it once; the rest are told
+	// it is already registered, and the shared blob stays in place
+	var wg sync.WaitGroup
+	codes := make([]int, 8)
+	for i := range codes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			w := serve(h.CreateContent, "/api/content", "POST", "/api/content", testOwner, bytes.NewReader(body), http.Header{"Content-Type": {"application/json"}})
+			codes[i] = w.Code
+		}(i)
+	}
+	wg.Wait()
+
+	created := 0
+	for _, code := range codes {
+		switch code {
+		case http.StatusCreated:
+			created++
+		case http.StatusConflict:
+		default:
+			t.Errorf("CreateContent = %d, want 201 or 409", code)
+		}
+	}
+	if created != 1 {
+		t.Fatalf("%d of %d uploads created content, want 1", created, len(codes))
+	}
+
+	result, err := h.store.GetAllContent(database.ListOptions{})
+	if err != nil || result.Total != 1 {
+		t.Fatalf("store holds %+v, %v; want one item", result, err)
+	}
+	if _, err := h.blobs.Stat(t.Context(), result.Items[0].ImageBlob); err != nil {
+		t.Fatalf("blob of the registered content: %v", err)
+	}
+}
+
+func TestCreateContentDuplicateOfHiddenContent(t *testing.T) {
+	h := newTestContent(t)
+	body := createRequest(t)
+	create := func(subject string) *httptest.ResponseRecorder {
+		return serve(h.CreateContent, "/api/content", "POST", "/api/content", subject, bytes.NewReader(body), http.Header{"Content-Type": {"application/json"}})
+	}
+	if w := create(testOwner); w.Code != http.StatusCreated {
+		t.Fatalf("CreateContent = %d %s", w.Code, w.Body)
+	}
+	result, _ := h.store.GetAllContent(database.ListOptions{})
+	existing := result.Items[0]
+	existing.Hidden = true
+	if err := h.store.UpdateContent(existing); err != nil {
+		t.Fatalf("UpdateContent: %v", err)
+	}
+
+	// Someone else is not told the ID of moderated content
+	w := create("0x0000000000000000000000000000000000000bad")
+	if w.Code != http.StatusConflict || w.Header().Get("Location") != "" || strings.Contains(w.Body.String(), existing.ID) {
+		t.Fatalf("duplicate of hidden content = %d, Location %q, body %s", w.Code, w.Header().Get("Location"), w.Body)
+	}
+
+	// The owner can still see it
+	w = create(testOwner)
+	if w.Code != http.StatusConflict || w.Header().Get("Location") != "/api/content/"+existing.ID {
+		t.Fatalf("owner's duplicate = %d, Location %q", w.Code, w.Header().Get("Location"))
+	}
+}
@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"licenz-backend/models"
+)
+
+// testImage stands in for image bytes; downloads do not decode them
+var testImage = bytes.Repeat([]byte("0123456789abcdef"), 64)
+
+// newDownloadTest stores testImage as the blob of content "a"
+func newDownloadTest(t *testing.T, content models.Content) (*testContent, string) {
+	t.Helper()
+	h := newTestContent(t)
+	blob, err := h.blobs.Put(context.Background(), bytes.NewReader(testImage))
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	content.ID = "a"
+	content.ImageBlob = blob.Key
+	content.MimeType = "image/png"
+	content.UserID = testOwner
+	content.UpdatedAt = time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	if err := h.store.CreateContent(content); err != nil {
+		t.Fatalf("CreateContent: %v", err)
+	}
+	return h, imageETag(blob.Key)
+}
+
+// download requests content "a"'s image
+func (h *testContent) download(method, subject string, header http.Header) *httptest.ResponseRecorder {
+	return serve(h.DownloadContent, "/api/content/:id/download", method, "/api/content/a/download", subject, nil, header)
+}
+
+func TestDownloadContent(t *testing.T) {
+	h, etag := newDownloadTest(t, models.Content{IsPublic: true})
+
+	w := h.download(http.MethodGet, "", nil)
+	if w.Code != http.StatusOK || !bytes.Equal(w.Body.Bytes(), testImage) {
+		t.Fatalf("GET = %d with %d bytes, want the %d image bytes", w.Code, w.Body.Len(), len(testImage))
+	}
+	for name, want := range map[string]string{
+		"Content-Type":        "image/png",
+		"Content-Disposition": "attachment; filename=a.png",
+		"ETag":                etag,
+		"Accept-Ranges":       "bytes",
+		"Last-Modified":       "Fri, 02 Jan 2026 15:04:05 GMT",
+	} {
+		if got := w.Header().Get(name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestDownloadContentConditional(t *testing.T) {
+	h, etag := newDownloadTest(t, models.Content{IsPublic: true})
+
+	w := h.download(http.MethodGet, "", http.Header{"If-None-Match": {etag}})
+	if w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+		t.Fatalf("GET with a matching If-None-Match = %d with %d bytes, want an empty 304", w.Code, w.Body.Len())
+	}
+	if w.Header().Get("ETag") != etag {
+		t.Errorf("304 ETag = %q, want %q", w.Header().Get("ETag"), etag)
+	}
+
+	w = h.download(http.MethodGet, "", http.Header{"If-None-Match": {`"sha256-stale"`}})
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET with a stale If-None-Match = %d, want 200", w.Code)
+	}
+}
+
+func TestDownloadContentRange(t *testing.T) {
+	h, etag := newDownloadTest(t, models.Content{IsPublic: true})
+
+	w := h.download(http.MethodGet, "", http.Header{"Range": {"bytes=16-31"}})
+	if w.Code != http.StatusPartialContent || !bytes.Equal(w.Body.Bytes(), testImage[16:32]) {
+		t.Fatalf("ranged GET = %d %q, want 206 with bytes 16-31", w.Code, w.Body.String())
+	}
+	if got, want := w.Header().Get("Content-Range"), "bytes 16-31/"+strconv.Itoa(len(testImage)); got != want {
+		t.Errorf("Content-Range = %q, want %q", got, want)
+	}
+
+	// A range for an older version is ignored in favour of the whole image
+	w = h.download(http.MethodGet, "", http.Header{"Range": {"bytes=16-31"}, "If-Range": {`"sha256-stale"`}})
+	if w.Code != http.StatusOK || w.Body.Len() != len(testImage) {
+		t.Fatalf("GET with a stale If-Range = %d with %d bytes, want the whole image", w.Code, w.Body.Len())
+	}
+
+	w = h.download(http.MethodGet, "", http.Header{"Range": {"bytes=" + strconv.Itoa(len(testImage)) + "-"}, "If-Range": {etag}})
+	if w.Code != http.StatusRequestedRangeNotSatisfiable {
+		t.Fatalf("GET past the end = %d, want 416", w.Code)
+	}
+}
+
+func TestDownloadContentHead(t *testing.T) {
+	h, etag := newDownloadTest(t, models.Content{IsPublic: true})
+
+	w := h.download(http.MethodHead, "", nil)
+	if w.Code != http.StatusOK || w.Body.Len() != 0 {
+		t.Fatalf("HEAD = %d with %d bytes, want 200 without a body", w.Code, w.Body.Len())
+	}
+	if got := w.Header().Get("Content-Length"); got != strconv.Itoa(len(testImage)) {
+		t.Errorf("Content-Length = %q, want %d", got, len(testImage))
+	}
+	if w.Header().Get("ETag") != etag {
+		t.Errorf("ETag = %q, want %q", w.Header().Get("ETag"), etag)
+	}
+}
+
+func TestDownloadContentCaching(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		content models.Content
+		subject string
+		public  bool
+	}{
+		{"public", models.Content{IsPublic: true}, "", true},
+		{"private", models.Content{IsPublic: false}, testOwner, false},
+		{"hidden", models.Content{IsPublic: true, Hidden: true}, testOwner, false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			h, _ := newDownloadTest(t, test.content)
+			w := h.download(http.MethodGet, test.subject, nil)
+			if w.Code != http.StatusOK {
+				t.Fatalf("GET = %d", w.Code)
+			}
+
+			cacheControl, vary := w.Header().Get("Cache-Control"), w.Header().Get("Vary")
+			if test.public {
+				if cacheControl != "public, max-age="+strconv.Itoa(publicImageMaxAge) || vary != "" {
+					t.Errorf("Cache-Control = %q, Vary = %q; want a public max-age and no Vary", cacheControl, vary)
+				}
+				return
+			}
+			if cacheControl != "private, no-cache" || vary != "Authorization" {
+				t.Errorf("Cache-Control = %q, Vary = %q; want private, no-cache varying on Authorization", cacheControl, vary)
+			}
+		})
+	}
+}
+
+func TestDownloadHiddenContentIsNotFound(t *testing.T) {
+	h, _ := newDownloadTest(t, models.Content{IsPublic: true, Hidden: true})
+
+	if w := h.download(http.MethodGet, "", nil); w.Code != http.StatusNotFound {
+		t.Fatalf("anonymous GET of hidden content = %d, want 404", w.Code)
+	}
+}
+
+func TestDownloadInlineImage(t *testing.T) {
+	h := newTestContent(t)
+	content := models.Content{ID: "a", ImageData: base64.StdEncoding.EncodeToString(testImage), IsPublic: true}
+	if err := h.store.CreateContent(content); err != nil {
+		t.Fatalf("CreateContent: %v", err)
+	}
+
+	sum := sha256.Sum256(testImage)
+	w := h.download(http.MethodGet, "", http.Header{"If-None-Match": {imageETag(hex.EncodeToString(sum[:]))}})
+	if w.Code != http.StatusNotModified {
+		t.Fatalf("conditional GET of an inline image = %d, want 304", w.Code)
+	}
+	w = h.download(http.MethodGet, "", nil)
+	if w.Code != http.StatusOK || !bytes.Equal(w.Body.Bytes(), testImage) {
+		t.Fatalf("GET of an inline image = %d with %d bytes", w.Code, w.Body.Len())
+	}
+}
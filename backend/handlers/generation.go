@@ -6,10 +6,22 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"licenz-backend/models"
+	"licenz-backend/ratelimit"
 )
 
+// GenerationHandler serves the /api/generate routes. Each tracked
+// generation counts against the caller's daily generation quota.
+type GenerationHandler struct {
+	quotas *ratelimit.Quotas
+}
+
+// NewGenerationHandler creates a generation handler enforcing quotas
+func NewGenerationHandler(quotas *ratelimit.Quotas) *GenerationHandler {
+	return &GenerationHandler{quotas: quotas}
+}
+
 // TrackGeneration handles POST /api/generate
-func TrackGeneration(c *gin.Context) {
+func (h *GenerationHandler) TrackGeneration(c *gin.Context) {
 	var req models.GenerationRequest
 	
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -20,6 +32,12 @@ func TrackGeneration(c *gin.Context) {
 		return
 	}
 
+	d, err := h.quotas.ReserveGeneration(ratelimit.UserKey(c))
+	if err != nil {
+		ratelimit.Abort(c, d, "Daily generation quota exceeded")
+		return
+	}
+
 	// Generate unique generation ID
 	generationID := uuid.New().String()
 	
@@ -41,7 +59,7 @@ func TrackGeneration(c *gin.Context) {
 }
 
 // GetGenerationHistory handles GET /api/generate/history
-func GetGenerationHistory(c *gin.Context) {
+func (h *GenerationHandler) GetGenerationHistory(c *gin.Context) {
 	// For now, return empty history
 	// In a real implementation, this would return actual generation history
 	c.JSON(http.StatusOK, gin.H{
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"licenz-backend/auth"
+	"licenz-backend/blobstore"
+	"licenz-backend/database"
+	"licenz-backend/derivatives"
+	"licenz-backend/imaging"
+	"licenz-backend/models"
+	"licenz-backend/ratelimit"
+)
+
+// testOwner is the subject that owns test content
+const testOwner = "0x00000000000000000000000000000000000000Aa"
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// testContent is a content handler on a MemoryDB with its blob store
+type testContent struct {
+	*ContentHandler
+	store database.ContentStore
+	blobs blobstore.Store
+}
+
+// newTestContent creates a content handler whose files live in temporary
+// directories
+func newTestContent(t *testing.T) *testContent {
+	t.Helper()
+	blobs, err := blobstore.NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFilesystemStore: %v", err)
+	}
+	gen, err := derivatives.NewGenerator(blobs, t.TempDir(), derivatives.DefaultSizes, 0, imaging.DefaultLimits)
+	if err != nil {
+		t.Fatalf("NewGenerator: %v", err)
+	}
+	thumbs := derivatives.NewPool(gen, 1, 8)
+	t.Cleanup(thumbs.Close)
+
+	store := database.NewMemoryDB()
+	quotas := ratelimit.NewQuotas(ratelimit.Quota{})
+	h := NewContentHandler(store, blobs, nil, quotas, imaging.DefaultLimits, thumbs, t.TempDir())
+	return &testContent{ContentHandler: h, store: store, blobs: blobs}
+}
+
+// serve runs one request through handler, signed in as subject unless it
+// is empty
+func serve(handler gin.HandlerFunc, route, method, target, subject string, body io.Reader, header http.Header) *httptest.ResponseRecorder {
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if subject != "" {
+			ctx := auth.WithIdentity(c.Request.Context(), &auth.Identity{Subject: subject, Method: "test"})
+			c.Request = c.Request.WithContext(ctx)
+		}
+	})
+	r.Handle(method, route, handler)
+
+	req := httptest.NewRequest(method, target, body)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+// decodeResponse decodes a ContentResponse body, leaving it empty when the
+// body is not JSON
+func decodeResponse(w *httptest.ResponseRecorder) models.ContentResponse {
+	var resp models.ContentResponse
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	return resp
+}
@@ -0,0 +1,479 @@
+package handlers
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+	"licenz-backend/auth"
+	"licenz-backend/database"
+	"licenz-backend/models"
+	"licenz-backend/services"
+)
+
+// LicenseHandler serves the /api/licenses routes on top of the LicenZLicense
+// contract. Every write is returned as an unsigned transaction for the
+// user's wallet; the contract, not the server, holds the licenses.
+type LicenseHandler struct {
+	licenses *services.LicenseService
+	store    database.ContentStore
+}
+
+// NewLicenseHandler creates a license handler. licenses may be nil when no
+// contract is configured, in which case every route answers 503.
+func NewLicenseHandler(licenses *services.LicenseService, store database.ContentStore) *LicenseHandler {
+	return &LicenseHandler{licenses: licenses, store: store}
+}
+
+// Available aborts with 503 when licensing is not configured
+func (h *LicenseHandler) Available(c *gin.Context) {
+	if h.licenses == nil {
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, models.LicenseResponse{
+			Success: false,
+			Error:   "Licensing is not configured",
+		})
+	}
+}
+
+// CreateLicense handles POST /api/licenses
+//
+// The content must be registered here and owned by the caller. The answer
+// is a createLicense transaction to sign and send from the creator's
+// wallet, which the contract pays on every sale; POST its hash to
+// /api/licenses/confirm once sent.
+func (h *LicenseHandler) CreateLicense(c *gin.Context) {
+	var req models.CreateLicenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.LicenseResponse{
+			Success: false,
+			Error:   "Invalid request data: " + err.Error(),
+		})
+		return
+	}
+
+	contentHash, err := parseHash(req.ContentHash)
+	if err != nil || contentHash == (common.Hash{}) {
+		c.JSON(http.StatusBadRequest, models.LicenseResponse{
+			Success: false,
+			Error:   "content_hash must be a non-zero 0x-prefixed 32-byte hex string",
+		})
+		return
+	}
+	price, ok := new(big.Int).SetString(req.PriceWei, 10)
+	if !ok || price.Sign() <= 0 {
+		c.JSON(http.StatusBadRequest, models.LicenseResponse{
+			Success: false,
+			Error:   "price_wei must be a positive whole number of wei",
+		})
+		return
+	}
+	terms := strings.TrimSpace(req.Terms)
+	if terms == "" {
+		c.JSON(http.StatusBadRequest, models.LicenseResponse{
+			Success: false,
+			Error:   "terms cannot be empty",
+		})
+		return
+	}
+
+	content, err := findByHash(h.store, contentHash.Hex())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.LicenseResponse{
+			Success: false,
+			Error:   "Failed to retrieve content: " + err.Error(),
+		})
+		return
+	}
+	if content == nil || (content.Hidden && !auth.Allowed(c.Request.Context(), auth.ActionViewHidden, content)) {
+		c.JSON(http.StatusNotFound, models.LicenseResponse{
+			Success: false,
+			Error:   "No content is registered with this hash",
+		})
+		return
+	}
+	if !authorize(c, auth.ActionUpdateContent, content) {
+		return
+	}
+
+	from, ok := walletAddress(c, req.From)
+	if !ok {
+		return
+	}
+
+	tx, err := h.licenses.BuildCreateTx(from, contentHash, price, terms)
+	if err != nil {
+		licenseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":     true,
+		"message":     "Sign and send this transaction, then confirm it at /api/licenses/confirm",
+		"content_id":  content.ID,
+		"transaction": tx,
+	})
+}
+
+// ConfirmLicense handles POST /api/licenses/confirm
+//
+// Reads the license a mined createLicense transaction created and marks the
+// licensed content as such, if its owner is the license's creator. A
+// transaction that is not mined yet is answered with 202.
+func (h *LicenseHandler) ConfirmLicense(c *gin.Context) {
+	var req models.ConfirmTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.LicenseResponse{
+			Success: false,
+			Error:   "Invalid request data: " + err.Error(),
+		})
+		return
+	}
+	txHash, err := parseHash(req.TxHash)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.LicenseResponse{
+			Success: false,
+			Error:   "tx_hash must be a 0x-prefixed 32-byte hex string",
+		})
+		return
+	}
+
+	license, err := h.licenses.ConfirmLicense(txHash)
+	if errors.Is(err, services.ErrTxPending) {
+		c.Header("Retry-After", "5")
+		c.JSON(http.StatusAccepted, models.LicenseResponse{
+			Success: true,
+			Message: "Transaction is not mined yet",
+		})
+		return
+	}
+	if err != nil {
+		licenseError(c, err)
+		return
+	}
+
+	// The license is on chain whatever happens here; the flag on the
+	// record is only a convenience for listings
+	message := "License confirmed"
+	content, err := findByHash(h.store, license.ContentHash.Hex())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.LicenseResponse{
+			Success: false,
+			Error:   "Failed to retrieve content: " + err.Error(),
+		})
+		return
+	}
+	if content != nil && strings.EqualFold(content.UserID, license.Creator.Hex()) && !content.IsLicensed {
+		if _, err := updateContent(h.store, content.ID, func(content *models.Content) {
+			content.IsLicensed = true
+		}); err != nil {
+			c.JSON(http.StatusInternalServerError, models.LicenseResponse{
+				Success: false,
+				Error:   "Failed to update content: " + err.Error(),
+			})
+			return
+		}
+		message = "License confirmed and content marked as licensed"
+	}
+
+	view := licenseView(license)
+	c.JSON(http.StatusOK, models.LicenseResponse{
+		Success: true,
+		Message: message,
+		Data:    &view,
+	})
+}
+
+// ListLicenses handles GET /api/licenses
+//
+// Exactly one of ?content_hash=, ?creator= or ?purchaser= selects the
+// licenses.
+func (h *LicenseHandler) ListLicenses(c *gin.Context) {
+	var (
+		licenses []*services.License
+		err      error
+	)
+	contentHash, creator, purchaser := c.Query("content_hash"), c.Query("creator"), c.Query("purchaser")
+	switch {
+	case contentHash != "" && creator == "" && purchaser == "":
+		hash, parseErr := parseHash(contentHash)
+		if parseErr != nil {
+			c.JSON(http.StatusBadRequest, models.LicenseListResponse{
+				Success: false,
+				Error:   "content_hash must be a 0x-prefixed 32-byte hex string",
+			})
+			return
+		}
+		licenses, err = h.licenses.LicensesForContent(hash)
+	case creator != "" && contentHash == "" && purchaser == "":
+		if !common.IsHexAddress(creator) {
+			c.JSON(http.StatusBadRequest, models.LicenseListResponse{
+				Success: false,
+				Error:   "creator must be an Ethereum address",
+			})
+			return
+		}
+		licenses, err = h.licenses.LicensesByCreator(common.HexToAddress(creator))
+	case purchaser != "" && contentHash == "" && creator == "":
+		if !common.IsHexAddress(purchaser) {
+			c.JSON(http.StatusBadRequest, models.LicenseListResponse{
+				Success: false,
+				Error:   "purchaser must be an Ethereum address",
+			})
+			return
+		}
+		licenses, err = h.licenses.LicensesByPurchaser(common.HexToAddress(purchaser))
+	default:
+		c.JSON(http.StatusBadRequest, models.LicenseListResponse{
+			Success: false,
+			Error:   "Specify one of content_hash, creator or purchaser",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadGateway, models.LicenseListResponse{
+			Success: false,
+			Error:   "Failed to retrieve licenses: " + err.Error(),
+		})
+		return
+	}
+
+	views := make([]models.License, 0, len(licenses))
+	for _, license := range licenses {
+		views = append(views, licenseView(license))
+	}
+	c.JSON(http.StatusOK, models.LicenseListResponse{
+		Success: true,
+		Data:    views,
+		Total:   len(views),
+	})
+}
+
+// GetLicense handles GET /api/licenses/:id
+func (h *LicenseHandler) GetLicense(c *gin.Context) {
+	id, ok := licenseID(c)
+	if !ok {
+		return
+	}
+
+	license, err := h.licenses.GetLicense(id)
+	if err != nil {
+		licenseError(c, err)
+		return
+	}
+
+	view := licenseView(license)
+	c.JSON(http.StatusOK, models.LicenseResponse{
+		Success: true,
+		Data:    &view,
+	})
+}
+
+// VerifyLicense handles GET /api/licenses/:id/valid
+//
+// Asks the contract whether the license exists, is active and unsold.
+func (h *LicenseHandler) VerifyLicense(c *gin.Context) {
+	id, ok := licenseID(c)
+	if !ok {
+		return
+	}
+
+	valid, err := h.licenses.IsLicenseValid(id)
+	if err != nil {
+		licenseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"license_id": id.String(),
+		"valid":      valid,
+	})
+}
+
+// PurchaseLicense handles POST /api/licenses/:id/purchase
+//
+// Answers with a purchaseLicense transaction, carrying the price, for the
+// buyer's wallet: "from" in the body, or the caller's own wallet.
+func (h *LicenseHandler) PurchaseLicense(c *gin.Context) {
+	id, ok := licenseID(c)
+	if !ok {
+		return
+	}
+	buyer, ok := bindWallet(c)
+	if !ok {
+		return
+	}
+
+	tx, err := h.licenses.BuildPurchaseTx(id, buyer)
+	if err != nil {
+		licenseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":     true,
+		"message":     "Sign and send this transaction to purchase the license",
+		"transaction": tx,
+	})
+}
+
+// DeactivateLicense handles POST /api/licenses/:id/deactivate
+//
+// Answers with a deactivateLicense transaction for the creator's wallet.
+// Licenses that were already purchased cannot be deactivated.
+func (h *LicenseHandler) DeactivateLicense(c *gin.Context) {
+	id, ok := licenseID(c)
+	if !ok {
+		return
+	}
+	creator, ok := bindWallet(c)
+	if !ok {
+		return
+	}
+
+	tx, err := h.licenses.BuildDeactivateTx(id, creator)
+	if err != nil {
+		licenseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":     true,
+		"message":     "Sign and send this transaction to deactivate the license",
+		"transaction": tx,
+	})
+}
+
+// licenseError answers with the status matching a LicenseService error
+func licenseError(c *gin.Context, err error) {
+	status := http.StatusBadGateway
+	switch {
+	case errors.Is(err, services.ErrLicenseNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, services.ErrLicenseInactive), errors.Is(err, services.ErrLicensePurchased):
+		status = http.StatusConflict
+	case errors.Is(err, services.ErrCreatorPurchase), errors.Is(err, services.ErrNotLicenseCreator):
+		status = http.StatusForbidden
+	case errors.Is(err, services.ErrTxFailed):
+		status = http.StatusUnprocessableEntity
+	}
+	c.JSON(status, models.LicenseResponse{
+		Success: false,
+		Error:   err.Error(),
+	})
+}
+
+// licenseID parses the :id parameter as a license ID
+func licenseID(c *gin.Context) (*big.Int, bool) {
+	return positiveID(c, "License ID")
+}
+
+// positiveID parses the :id parameter, writing a 400 response itself when
+// it is not a positive integer
+func positiveID(c *gin.Context, name string) (*big.Int, bool) {
+	id, ok := new(big.Int).SetString(c.Param("id"), 10)
+	if !ok || id.Sign() <= 0 {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   name + " must be a positive integer",
+		})
+		return nil, false
+	}
+	return id, true
+}
+
+// parseHash parses a 0x-prefixed 32-byte hex string
+func parseHash(value string) (common.Hash, error) {
+	b, err := hexutil.Decode(value)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(b) != common.HashLength {
+		return common.Hash{}, errors.New("hash must be 32 bytes")
+	}
+	return common.BytesToHash(b), nil
+}
+
+// bindWallet reads the optional WalletRequest body and resolves the wallet
+// it names
+func bindWallet(c *gin.Context) (common.Address, bool) {
+	var req models.WalletRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, models.LicenseResponse{
+				Success: false,
+				Error:   "Invalid request data: " + err.Error(),
+			})
+			return common.Address{}, false
+		}
+	}
+	return walletAddress(c, req.From)
+}
+
+// walletAddress is the wallet a transaction is prepared for: from when
+// given, otherwise the caller's own if they signed in with a wallet. It
+// writes a 400 response itself when there is none.
+func walletAddress(c *gin.Context, from string) (common.Address, bool) {
+	if from == "" {
+		if identity, ok := auth.FromContext(c.Request.Context()); ok {
+			from = identity.Subject
+		}
+	}
+	if !common.IsHexAddress(from) {
+		c.JSON(http.StatusBadRequest, models.LicenseResponse{
+			Success: false,
+			Error:   "Specify the wallet address to send from in \"from\"",
+		})
+		return common.Address{}, false
+	}
+	return common.HexToAddress(from), true
+}
+
+// updateContent applies change to a stored record, starting over from a
+// fresh copy when a concurrent update lands first, and returns the result
+func updateContent(store database.ContentStore, id string, change func(*models.Content)) (*models.Content, error) {
+	for attempt := 0; ; attempt++ {
+		content, err := store.GetContent(id)
+		if err != nil {
+			return nil, err
+		}
+		if content == nil {
+			return nil, database.ErrNotFound
+		}
+		change(content)
+		err = store.UpdateContent(*content)
+		if errors.Is(err, database.ErrVersionConflict) && attempt < 3 {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return store.GetContent(id)
+	}
+}
+
+// licenseView converts an on-chain license for the API
+func licenseView(l *services.License) models.License {
+	view := models.License{
+		ID:          l.ID.String(),
+		ContentHash: l.ContentHash.Hex(),
+		PriceWei:    l.Price.String(),
+		Terms:       l.Terms,
+		Creator:     l.Creator.Hex(),
+		IsActive:    l.IsActive,
+		IsPurchased: l.IsPurchased,
+		CreatedAt:   time.Unix(l.CreatedAt.Int64(), 0).UTC(),
+		Valid:       l.Valid(),
+	}
+	if l.IsPurchased {
+		purchasedAt := time.Unix(l.PurchasedAt.Int64(), 0).UTC()
+		view.Purchaser = l.Purchaser.Hex()
+		view.PurchasedAt = &purchasedAt
+	}
+	return view
+}
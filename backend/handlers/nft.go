@@ -0,0 +1,288 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"licenz-backend/auth"
+	"licenz-backend/database"
+	"licenz-backend/models"
+	"licenz-backend/services"
+)
+
+// NFTHandler mints content as LicenZNFT tokens and serves token lookups
+type NFTHandler struct {
+	nfts  *services.NFTService
+	store database.ContentStore
+}
+
+// NewNFTHandler creates an NFT handler. nfts may be nil when no contract is
+// configured, in which case every route answers 503.
+func NewNFTHandler(nfts *services.NFTService, store database.ContentStore) *NFTHandler {
+	return &NFTHandler{nfts: nfts, store: store}
+}
+
+// Available aborts with 503 when minting is not configured
+func (h *NFTHandler) Available(c *gin.Context) {
+	if h.nfts == nil {
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, models.NFTResponse{
+			Success: false,
+			Error:   "NFT minting is not configured",
+		})
+	}
+}
+
+// MintContent handles POST /api/content/:id/mint
+//
+// Mints the caller's content, keyed by its ContentHash. When the server
+// holds a minter key it mints to "to" itself, waits for the transaction and
+// marks the record as minted. Otherwise, or when "wallet" is set, it answers
+// with a mintNFT transaction for the user's wallet; POST its hash to
+// /api/content/:id/mint/confirm once sent.
+func (h *NFTHandler) MintContent(c *gin.Context) {
+	var req models.MintRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   "Invalid request data: " + err.Error(),
+		})
+		return
+	}
+	uri := strings.TrimSpace(req.TokenURI)
+	if uri == "" {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   "token_uri cannot be empty",
+		})
+		return
+	}
+
+	content, contentHash, ok := h.loadMintable(c)
+	if !ok {
+		return
+	}
+	if content.NFTMinted {
+		c.JSON(http.StatusConflict, gin.H{
+			"success":      false,
+			"error":        "Content is already minted",
+			"nft_token_id": content.NFTTokenID,
+		})
+		return
+	}
+
+	to, ok := walletAddress(c, req.To)
+	if !ok {
+		return
+	}
+
+	if req.Wallet || !h.nfts.Custodial() {
+		from, ok := walletAddress(c, req.From)
+		if !ok {
+			return
+		}
+		tx, err := h.nfts.BuildMintTx(from, to, contentHash, uri)
+		if err != nil {
+			nftError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"success":     true,
+			"message":     "Sign and send this transaction, then confirm it at /api/content/" + content.ID + "/mint/confirm",
+			"transaction": tx,
+		})
+		return
+	}
+
+	result, err := h.nfts.Mint(to, contentHash, uri)
+	if err != nil {
+		nftError(c, err)
+		return
+	}
+	h.recordMint(c, content, contentHash, result)
+}
+
+// ConfirmMint handles POST /api/content/:id/mint/confirm
+//
+// Reads the token a mined mintNFT transaction created for this content and
+// marks the record as minted. A transaction that is not mined yet is
+// answered with 202.
+func (h *NFTHandler) ConfirmMint(c *gin.Context) {
+	var req models.ConfirmTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   "Invalid request data: " + err.Error(),
+		})
+		return
+	}
+	txHash, err := parseHash(req.TxHash)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   "tx_hash must be a 0x-prefixed 32-byte hex string",
+		})
+		return
+	}
+
+	content, contentHash, ok := h.loadMintable(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.nfts.ConfirmMint(txHash)
+	if errors.Is(err, services.ErrTxPending) {
+		c.Header("Retry-After", "5")
+		c.JSON(http.StatusAccepted, models.ContentResponse{
+			Success: true,
+			Message: "Transaction is not mined yet",
+		})
+		return
+	}
+	if err != nil {
+		nftError(c, err)
+		return
+	}
+	h.recordMint(c, content, contentHash, result)
+}
+
+// GetNFTByContentHash handles GET /api/nfts?content_hash=
+//
+// Reports whether the content hash is minted, and the token if it is.
+func (h *NFTHandler) GetNFTByContentHash(c *gin.Context) {
+	contentHash, err := parseHash(c.Query("content_hash"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.NFTResponse{
+			Success: false,
+			Error:   "content_hash must be a 0x-prefixed 32-byte hex string",
+		})
+		return
+	}
+
+	token, err := h.nfts.TokenByContentHash(contentHash)
+	if errors.Is(err, services.ErrNotMinted) {
+		c.JSON(http.StatusOK, models.NFTResponse{Success: true, Minted: false})
+		return
+	}
+	if err != nil {
+		nftError(c, err)
+		return
+	}
+
+	view := nftView(token)
+	c.JSON(http.StatusOK, models.NFTResponse{
+		Success: true,
+		Minted:  true,
+		Data:    &view,
+	})
+}
+
+// GetNFT handles GET /api/nfts/:id
+//
+// Returns a token with its tokenURI.
+func (h *NFTHandler) GetNFT(c *gin.Context) {
+	id, ok := positiveID(c, "Token ID")
+	if !ok {
+		return
+	}
+
+	token, err := h.nfts.TokenByID(id)
+	if err != nil {
+		nftError(c, err)
+		return
+	}
+
+	view := nftView(token)
+	c.JSON(http.StatusOK, models.NFTResponse{
+		Success: true,
+		Minted:  true,
+		Data:    &view,
+	})
+}
+
+// loadMintable loads the :id content for its owner and parses its content
+// hash, the key LicenZNFT mints under
+func (h *NFTHandler) loadMintable(c *gin.Context) (*models.Content, common.Hash, bool) {
+	content, ok := loadContent(c, h.store)
+	if !ok {
+		return nil, common.Hash{}, false
+	}
+	if !authorize(c, auth.ActionUpdateContent, content) {
+		return nil, common.Hash{}, false
+	}
+
+	contentHash, err := parseHash(content.ContentHash)
+	if err != nil || contentHash == (common.Hash{}) {
+		c.JSON(http.StatusUnprocessableEntity, models.ContentResponse{
+			Success: false,
+			Error:   "Content has no valid content hash to mint",
+		})
+		return nil, common.Hash{}, false
+	}
+	return content, contentHash, true
+}
+
+// recordMint marks content as minted with the token a confirmed mint
+// created, and answers with the updated record
+func (h *NFTHandler) recordMint(c *gin.Context, content *models.Content, contentHash common.Hash, result *services.MintResult) {
+	if result.Token.ContentHash != contentHash {
+		c.JSON(http.StatusUnprocessableEntity, models.ContentResponse{
+			Success: false,
+			Error:   "Transaction minted a different content hash",
+		})
+		return
+	}
+
+	tokenID := result.Token.ID.String()
+	updated, err := updateContent(h.store, content.ID, func(content *models.Content) {
+		content.NFTMinted = true
+		content.NFTTokenID = tokenID
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ContentResponse{
+			Success: false,
+			Error:   "NFT minted as token " + tokenID + " but the content could not be updated: " + err.Error(),
+		})
+		return
+	}
+
+	view := nftView(result.Token)
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "NFT minted successfully",
+		"data":    updated,
+		"nft":     view,
+		"tx_hash": result.TxHash.Hex(),
+	})
+}
+
+// nftError answers with the status matching an NFTService error
+func nftError(c *gin.Context, err error) {
+	status := http.StatusBadGateway
+	switch {
+	case errors.Is(err, services.ErrNotMinted):
+		status = http.StatusNotFound
+	case errors.Is(err, services.ErrContentMinted):
+		status = http.StatusConflict
+	case errors.Is(err, services.ErrTxFailed):
+		status = http.StatusUnprocessableEntity
+	}
+	c.JSON(status, models.NFTResponse{
+		Success: false,
+		Error:   err.Error(),
+	})
+}
+
+// nftView converts an on-chain token for the API
+func nftView(t *services.Token) models.NFT {
+	return models.NFT{
+		TokenID:     t.ID.String(),
+		ContentHash: t.ContentHash.Hex(),
+		Creator:     t.Creator.Hex(),
+		TokenURI:    t.TokenURI,
+		CreatedAt:   time.Unix(t.CreatedAt.Int64(), 0).UTC(),
+	}
+}
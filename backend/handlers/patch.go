@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"licenz-backend/auth"
+	"licenz-backend/database"
+	"licenz-backend/models"
+)
+
+// maxLicenseTypeLength bounds the free-form license type label
+const maxLicenseTypeLength = 64
+
+// maxPatchBytes bounds a PATCH body; real patches are a few fields
+const maxPatchBytes = 16 << 10
+
+// patchableFields is the allow-list of fields a PATCH may change, keyed by
+// their JSON name on models.Content. Everything else, including the image,
+// its hash and the generation parameters, is immutable once created.
+// nft_minted and nft_token_id are only set by the mint endpoints, once the
+// chain confirms the token.
+var patchableFields = map[string]func(*models.Content) interface{}{
+	"is_public":    func(c *models.Content) interface{} { return &c.IsPublic },
+	"is_licensed":  func(c *models.Content) interface{} { return &c.IsLicensed },
+	"license_type": func(c *models.Content) interface{} { return &c.LicenseType },
+	"hidden":       func(c *models.Content) interface{} { return &c.Hidden },
+}
+
+// patchAction is the policy action needed to change a field. Owners edit
+// their content; hiding is a moderation decision.
+func patchAction(field string) auth.Action {
+	if field == "hidden" {
+		return auth.ActionHideContent
+	}
+	return auth.ActionUpdateContent
+}
+
+// PatchContent handles PATCH /api/content/:id
+//
+// The body is a JSON Merge Patch (RFC 7396) limited to patchableFields;
+// null resets a field to its zero value. Each field needs the policy action
+// from patchAction. Send the ETag from a previous read
+// in If-Match to make sure nobody changed the content in between; a stale
+// ETag, or a concurrent update that lands first, is answered with 409.
+func (h *ContentHandler) PatchContent(c *gin.Context) {
+	var patch map[string]json.RawMessage
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, maxPatchBytes)
+	if err := json.NewDecoder(body).Decode(&patch); err != nil || patch == nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, models.ContentResponse{
+				Success: false,
+				Error:   "Request body is too large",
+			})
+			return
+		}
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   "Request body must be a JSON object",
+		})
+		return
+	}
+
+	// Each field is authorized on its own, so an empty patch would update
+	// the version of content the caller may not touch
+	if len(patch) == 0 {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   "Patch must change at least one field",
+		})
+		return
+	}
+
+	content, ok := h.loadContent(c)
+	if !ok {
+		return
+	}
+	authorized := make(map[auth.Action]bool)
+	for field := range patch {
+		if _, ok := patchableFields[field]; !ok {
+			continue // rejected by applyMergePatch below
+		}
+		action := patchAction(field)
+		if authorized[action] {
+			continue
+		}
+		if !authorize(c, action, content) {
+			return
+		}
+		authorized[action] = true
+	}
+
+	if ifMatch := c.GetHeader("If-Match"); ifMatch != "" && !etagMatches(ifMatch, contentETag(content)) {
+		c.Header("ETag", contentETag(content))
+		c.JSON(http.StatusConflict, models.ContentResponse{
+			Success: false,
+			Error:   "Content has been modified since it was read",
+			Data:    content,
+		})
+		return
+	}
+
+	updated := *content
+	if err := applyMergePatch(&updated, patch); err != nil {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+	if err := validatePatchedContent(&updated); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, models.ContentResponse{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	if err := h.store.UpdateContent(updated); err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, database.ErrVersionConflict):
+			status = http.StatusConflict
+		case errors.Is(err, database.ErrNotFound):
+			status = http.StatusNotFound
+		}
+		c.JSON(status, models.ContentResponse{
+			Success: false,
+			Error:   "Failed to update content: " + err.Error(),
+		})
+		return
+	}
+
+	// Re-read so the response carries the version and timestamp the store set
+	content, ok = h.loadContent(c)
+	if !ok {
+		return
+	}
+
+	h.withThumbnails(content)
+	c.Header("ETag", contentETag(content))
+	c.JSON(http.StatusOK, models.ContentResponse{
+		Success: true,
+		Message: "Content updated successfully",
+		Data:    content,
+	})
+}
+
+// applyMergePatch sets each patched field on content, rejecting fields
+// outside the allow-list and values of the wrong type
+func applyMergePatch(content *models.Content, patch map[string]json.RawMessage) error {
+	for name, raw := range patch {
+		field, ok := patchableFields[name]
+		if !ok {
+			return fmt.Errorf("field %q cannot be modified", name)
+		}
+
+		ptr := field(content)
+		if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+			switch p := ptr.(type) {
+			case *bool:
+				*p = false
+			case *string:
+				*p = ""
+			}
+			continue
+		}
+		if err := json.Unmarshal(raw, ptr); err != nil {
+			return fmt.Errorf("field %q has the wrong type", name)
+		}
+	}
+	return nil
+}
+
+// validatePatchedContent checks the rules that span fields
+func validatePatchedContent(content *models.Content) error {
+	content.LicenseType = strings.TrimSpace(content.LicenseType)
+	if len(content.LicenseType) > maxLicenseTypeLength {
+		return fmt.Errorf("license_type must be at most %d characters", maxLicenseTypeLength)
+	}
+	if content.IsLicensed && content.LicenseType == "" {
+		return fmt.Errorf("license_type is required when is_licensed is true")
+	}
+	return nil
+}
+
+// contentETag is the entity tag for a content record's JSON representation
+func contentETag(content *models.Content) string {
+	return strconv.Quote(strconv.FormatInt(content.Version, 10))
+}
+
+// etagMatches reports whether an If-Match header accepts etag
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"licenz-backend/models"
+)
+
+// patch sends body as a PATCH of content "a"
+func (h *testContent) patch(subject, ifMatch, body string) (*models.Content, int, models.ContentResponse) {
+	header := http.Header{"Content-Type": {"application/merge-patch+json"}}
+	if ifMatch != "" {
+		header.Set("If-Match", ifMatch)
+	}
+	w := serve(h.PatchContent, "/api/content/:id", http.MethodPatch, "/api/content/a", subject, strings.NewReader(body), header)
+
+	stored, _ := h.store.GetContent("a")
+	return stored, w.Code, decodeResponse(w)
+}
+
+// newPatchTest stores content "a", owned by testOwner, at version 1
+func newPatchTest(t *testing.T) *testContent {
+	t.Helper()
+	h := newTestContent(t)
+	err := h.store.CreateContent(models.Content{
+		ID:       "a",
+		Prompt:   "a lighthouse at dusk",
+		Style:    "oil",
+		UserID:   testOwner,
+		IsPublic: true,
+		Version:  1,
+	})
+	if err != nil {
+		t.Fatalf("CreateContent: %v", err)
+	}
+	return h
+}
+
+func TestPatchContent(t *testing.T) {
+	h := newPatchTest(t)
+
+	stored, status, resp := h.patch(testOwner, `"1"`, `{"is_public": false, "is_licensed": true, "license_type": " commercial "}`)
+	if status != http.StatusOK {
+		t.Fatalf("PATCH = %d %+v", status, resp)
+	}
+	if stored.IsPublic || !stored.IsLicensed || stored.LicenseType != "commercial" || stored.Version != 2 {
+		t.Fatalf("stored = %+v, want the patch applied at version 2", stored)
+	}
+	if resp.Data == nil || resp.Data.Version != 2 {
+		t.Fatalf("response data = %+v, want version 2", resp.Data)
+	}
+
+	// null resets a field
+	if stored, status, _ = h.patch(testOwner, "", `{"is_licensed": null, "license_type": null}`); status != http.StatusOK || stored.IsLicensed {
+		t.Fatalf("PATCH with null = %d, stored %+v", status, stored)
+	}
+}
+
+func TestPatchContentRejectsStaleIfMatch(t *testing.T) {
+	h := newPatchTest(t)
+	if _, status, _ := h.patch(testOwner, `"1"`, `{"is_public": false}`); status != http.StatusOK {
+		t.Fatalf("first PATCH = %d", status)
+	}
+
+	// The caller read version 1, but version 2 is stored now
+	stored, status, resp := h.patch(testOwner, `"1"`, `{"license_type": "editorial"}`)
+	if status != http.StatusConflict {
+		t.Fatalf("stale PATCH = %d, want 409", status)
+	}
+	if resp.Data == nil || resp.Data.Version != 2 {
+		t.Errorf("409 carries %+v, want the current version 2", resp.Data)
+	}
+	if stored.LicenseType != "" || stored.Version != 2 {
+		t.Errorf("stored = %+v, want the stale patch dropped", stored)
+	}
+}
+
+func TestPatchContentRejectsFields(t *testing.T) {
+	for _, test := range []struct {
+		name, body string
+		status     int
+	}{
+		{"immutable prompt", `{"prompt": "something else"}`, http.StatusBadRequest},
+		{"immutable owner", `{"UserID": "0x0000000000000000000000000000000000000bad"}`, http.StatusBadRequest},
+		{"immutable hash", `{"ContentHash": "0x00"}`, http.StatusBadRequest},
+		{"mint status", `{"nft_minted": true, "nft_token_id": "5"}`, http.StatusBadRequest},
+		{"unknown field", `{"colour": "blue"}`, http.StatusBadRequest},
+		{"wrong type", `{"is_public": "no"}`, http.StatusBadRequest},
+		{"not an object", `["is_public"]`, http.StatusBadRequest},
+		{"empty", `{}`, http.StatusBadRequest},
+		{"licensed without a type", `{"is_licensed": true}`, http.StatusUnprocessableEntity},
+		{"too large", `{"license_type": "` + strings.Repeat("x", maxPatchBytes) + `"}`, http.StatusRequestEntityTooLarge},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			h := newPatchTest(t)
+			stored, status, _ := h.patch(testOwner, "", test.body)
+			if status != test.status {
+				t.Fatalf("PATCH %s = %d, want %d", test.body, status, test.status)
+			}
+			if stored.Version != 1 || stored.Prompt != "a lighthouse at dusk" || !stored.IsPublic || stored.NFTMinted {
+				t.Fatalf("stored = %+v, want it unchanged", stored)
+			}
+		})
+	}
+}
+
+func TestPatchContentChecksPermissions(t *testing.T) {
+	h := newPatchTest(t)
+
+	if _, status, _ := h.patch("", "", `{"is_public": false}`); status != http.StatusUnauthorized {
+		t.Errorf("anonymous PATCH = %d, want 401", status)
+	}
+	if _, status, _ := h.patch("0x0000000000000000000000000000000000000bad", "", `{"is_public": false}`); status != http.StatusForbidden {
+		t.Errorf("PATCH by another user = %d, want 403", status)
+	}
+	// An empty patch does not bump the version under another owner
+	if stored, status, _ := h.patch("0x0000000000000000000000000000000000000bad", "", `{}`); status != http.StatusBadRequest || stored.Version != 1 {
+		t.Errorf("empty PATCH by another user = %d, version %d; want 400 and version 1", status, stored.Version)
+	}
+	// Hiding is for moderators, even on one's own content
+	if _, status, _ := h.patch(testOwner, "", `{"hidden": true}`); status != http.StatusForbidden {
+		t.Errorf("owner hiding content = %d, want 403", status)
+	}
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"licenz-backend/database"
+)
+
+// parseContentFilter reads the listing filters from the query string:
+//
+//	user_id, style, model          exact match; style and model accept a comma-separated list
+//	is_licensed, nft_minted        true or false
+//	created_after, created_before  RFC 3339 timestamp or YYYY-MM-DD (after is inclusive, before exclusive)
+//	min_width, max_width, min_height, max_height  pixel bounds
+func parseContentFilter(c *gin.Context) (database.ContentFilter, error) {
+	filter := database.ContentFilter{
+		UserID: c.Query("user_id"),
+		Styles: splitList(c.Query("style")),
+		Models: splitList(c.Query("model")),
+	}
+
+	var err error
+	if filter.IsLicensed, err = queryBool(c, "is_licensed"); err != nil {
+		return filter, err
+	}
+	if filter.NFTMinted, err = queryBool(c, "nft_minted"); err != nil {
+		return filter, err
+	}
+	if filter.CreatedAfter, err = queryTime(c, "created_after"); err != nil {
+		return filter, err
+	}
+	if filter.CreatedBefore, err = queryTime(c, "created_before"); err != nil {
+		return filter, err
+	}
+
+	for name, dst := range map[string]*int{
+		"min_width":  &filter.MinWidth,
+		"max_width":  &filter.MaxWidth,
+		"min_height": &filter.MinHeight,
+		"max_height": &filter.MaxHeight,
+	} {
+		if *dst, err = queryInt(c, name); err != nil {
+			return filter, err
+		}
+	}
+
+	return filter, nil
+}
+
+// wantFacets reports whether the client asked for facet counts with ?facets=true
+func wantFacets(c *gin.Context) (bool, error) {
+	facets, err := queryBool(c, "facets")
+	if err != nil || facets == nil {
+		return false, err
+	}
+	return *facets, nil
+}
+
+// splitList splits a comma-separated query value, dropping empty entries
+func splitList(value string) []string {
+	var list []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// queryBool parses an optional boolean parameter
+func queryBool(c *gin.Context, name string) (*bool, error) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be true or false", name)
+	}
+	return &b, nil
+}
+
+// queryTime parses an optional RFC 3339 timestamp or calendar date
+func queryTime(c *gin.Context, name string) (time.Time, error) {
+	value := c.Query(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("%s must be an RFC 3339 timestamp or YYYY-MM-DD date", name)
+}
+
+// queryInt parses an optional non-negative integer parameter
+func queryInt(c *gin.Context, name string) (int, error) {
+	value := c.Query(name)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative integer", name)
+	}
+	return n, nil
+}
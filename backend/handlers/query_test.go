@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"licenz-backend/database"
+)
+
+// queryContext is a request context for the query string query
+func queryContext(query string) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/api/content?"+query, nil)
+	return c
+}
+
+func TestParseContentFilter(t *testing.T) {
+	licensed := true
+	minted := false
+
+	filter, err := parseContentFilter(queryContext(
+		"user_id=alice&style=oil,%20ink,,&model=sdxl&is_licensed=true&nft_minted=0" +
+			"&created_after=2026-03-01&created_before=2026-03-02T12:00:00Z" +
+			"&min_width=256&max_width=2048&min_height=0&max_height=1024"))
+	if err != nil {
+		t.Fatalf("parseContentFilter: %v", err)
+	}
+
+	want := database.ContentFilter{
+		UserID:        "alice",
+		Styles:        []string{"oil", "ink"},
+		Models:        []string{"sdxl"},
+		IsLicensed:    &licensed,
+		NFTMinted:     &minted,
+		CreatedAfter:  time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		CreatedBefore: time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC),
+		MinWidth:      256,
+		MaxWidth:      2048,
+		MaxHeight:     1024,
+	}
+	if !reflect.DeepEqual(filter, want) {
+		t.Fatalf("filter = %+v, want %+v", filter, want)
+	}
+
+	if empty, err := parseContentFilter(queryContext("")); err != nil || !reflect.DeepEqual(empty, database.ContentFilter{}) {
+		t.Fatalf("empty query = %+v, %v; want no filter", empty, err)
+	}
+}
+
+func TestParseContentFilterRejectsInvalidValues(t *testing.T) {
+	for _, query := range []string{
+		"is_licensed=maybe",
+		"nft_minted=yes",
+		"created_after=yesterday",
+		"created_before=2026-13-01",
+		"created_after=2026-03-01T12:00",
+		"min_width=-1",
+		"max_width=wide",
+		"min_height=1.5",
+		"max_height=9999999999999999999999",
+	} {
+		if _, err := parseContentFilter(queryContext(query)); err == nil {
+			t.Errorf("parseContentFilter(%q) succeeded", query)
+		}
+	}
+
+	for _, query := range []string{"facets=sometimes", "facets=2"} {
+		if _, err := wantFacets(queryContext(query)); err == nil {
+			t.Errorf("wantFacets(%q) succeeded", query)
+		}
+	}
+	if facets, err := wantFacets(queryContext("facets=true")); err != nil || !facets {
+		t.Errorf("wantFacets(facets=true) = %v, %v", facets, err)
+	}
+}
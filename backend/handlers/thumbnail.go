@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"licenz-backend/derivatives"
+	"licenz-backend/models"
+)
+
+// thumbnailRetrySeconds is the Retry-After sent while a thumbnail renders
+const thumbnailRetrySeconds = 2
+
+// GetThumbnail handles GET /api/content/:id/thumbnail
+//
+// ?size= picks one of the configured sizes (default the smallest). A
+// thumbnail that the background pool has not produced yet is queued and
+// answered with 202 and Retry-After, or a redirect to the original when the
+// queue is full or rendering failed. Images that cannot be thumbnailed
+// redirect to the original.
+func (h *ContentHandler) GetThumbnail(c *gin.Context) {
+	gen := h.thumbs.Generator()
+
+	size := gen.Sizes()[0]
+	if value := c.Query("size"); value != "" {
+		var err error
+		if size, err = strconv.Atoi(value); err != nil || !gen.HasSize(size) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   "Unknown thumbnail size",
+				"sizes":   gen.Sizes(),
+			})
+			return
+		}
+	}
+
+	content, ok := h.loadContent(c)
+	if !ok {
+		return
+	}
+	if content.ImageBlob == "" || !derivatives.Supported(content.MimeType) {
+		c.Redirect(http.StatusTemporaryRedirect, downloadURL(content.ID))
+		return
+	}
+
+	file, err := gen.Open(content.ImageBlob, size)
+	if os.IsNotExist(err) {
+		// Rendering decodes the whole original, so it is left to the
+		// bounded background pool rather than done in the request
+		if !h.thumbs.Request(content.ImageBlob) {
+			c.Redirect(http.StatusTemporaryRedirect, downloadURL(content.ID))
+			return
+		}
+		c.Header("Retry-After", strconv.Itoa(thumbnailRetrySeconds))
+		c.JSON(http.StatusAccepted, models.ContentResponse{
+			Success: true,
+			Message: "Thumbnail is being rendered",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ContentResponse{
+			Success: false,
+			Error:   "Failed to load thumbnail: " + err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ContentResponse{
+			Success: false,
+			Error:   "Failed to load thumbnail: " + err.Error(),
+		})
+		return
+	}
+
+	// A record's image never changes, so its thumbnails follow the same
+	// policy as the original. The tag is weak: the bytes depend on the
+	// configured quality.
+	c.Header("Content-Type", derivatives.MimeType)
+	setImageCaching(c, content, "W/"+imageETag(content.ImageBlob+"-"+strconv.Itoa(size)))
+	http.ServeContent(c.Writer, c.Request, content.ID+"_"+strconv.Itoa(size)+".jpg", stat.ModTime(), file)
+}
+
+// withThumbnails fills in content.Thumbnails with a URL per configured size
+func (h *ContentHandler) withThumbnails(content *models.Content) {
+	if content.ImageBlob == "" || !derivatives.Supported(content.MimeType) {
+		return
+	}
+	content.Thumbnails = make(map[string]string)
+	for _, size := range h.thumbs.Generator().Sizes() {
+		content.Thumbnails[strconv.Itoa(size)] = thumbnailURL(content.ID, size)
+	}
+}
+
+// thumbnailURL is the API path that serves a content item's thumbnail
+func thumbnailURL(contentID string, size int) string {
+	return "/api/content/" + contentID + "/thumbnail?size=" + strconv.Itoa(size)
+}
@@ -0,0 +1,274 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"licenz-backend/auth"
+	"licenz-backend/imaging"
+	"licenz-backend/models"
+	"licenz-backend/ratelimit"
+	"licenz-backend/uploads"
+)
+
+// tusVersion is the resumable upload protocol version spoken
+const tusVersion = "1.0.0"
+
+// UploadHandler serves /api/uploads, resumable uploads in the style of the
+// tus protocol: create an upload with its length, send the bytes in PATCH
+// chunks (resuming from the offset HEAD reports after a failure), then turn
+// the finished upload into content.
+type UploadHandler struct {
+	uploads *uploads.Manager
+	content *ContentHandler
+}
+
+// NewUploadHandler creates an upload handler that registers finished
+// uploads through content. An upload's length is charged to its owner's
+// storage quota when it is created and refunded if it is abandoned the same
+// day.
+func NewUploadHandler(manager *uploads.Manager, content *ContentHandler) *UploadHandler {
+	manager.OnExpire(func(upload *uploads.Upload) {
+		content.quotas.ReleaseStorage(ratelimit.SubjectKey(upload.Owner), upload.Length, upload.QuotaDay)
+	})
+	return &UploadHandler{uploads: manager, content: content}
+}
+
+// Options handles OPTIONS /api/uploads, tus capability discovery
+func (h *UploadHandler) Options(c *gin.Context) {
+	c.Header("Tus-Resumable", tusVersion)
+	c.Header("Tus-Version", tusVersion)
+	c.Header("Tus-Extension", "creation,termination")
+	if max := h.uploads.MaxBytes(); max > 0 {
+		c.Header("Tus-Max-Size", strconv.FormatInt(max, 10))
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// CreateUpload handles POST /api/uploads
+//
+// Upload-Length gives the file size; Upload-Metadata optionally carries
+// tus-encoded key/value pairs. The Location header names the new upload.
+// The length counts against the storage quota from the start, and too many
+// unfinished uploads get 429.
+func (h *UploadHandler) CreateUpload(c *gin.Context) {
+	c.Header("Tus-Resumable", tusVersion)
+	if !authorize(c, auth.ActionCreateContent, nil) {
+		return
+	}
+	identity, _ := auth.FromContext(c.Request.Context())
+
+	length, err := strconv.ParseInt(c.GetHeader("Upload-Length"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   "Upload-Length header is required",
+		})
+		return
+	}
+	metadata, err := parseUploadMetadata(c.GetHeader("Upload-Metadata"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	if length <= 0 {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   "Upload-Length must be positive",
+		})
+		return
+	}
+
+	// Reserve the whole upload now so open uploads cannot outgrow the quota
+	quotaKey := ratelimit.UserKey(c)
+	d, err := h.content.quotas.ReserveStorage(quotaKey, length)
+	if err != nil {
+		ratelimit.Abort(c, d, "Daily storage quota exceeded")
+		return
+	}
+
+	upload, err := h.uploads.Create(identity.Subject, length, d.Day, metadata)
+	if err != nil {
+		h.content.quotas.ReleaseStorage(quotaKey, length, d.Day)
+		status := http.StatusBadRequest
+		switch {
+		case errors.Is(err, imaging.ErrTooLarge):
+			status = http.StatusRequestEntityTooLarge
+		case errors.Is(err, uploads.ErrTooMany):
+			status = http.StatusTooManyRequests
+		}
+		c.JSON(status, models.ContentResponse{
+			Success: false,
+			Error:   "Failed to create upload: " + err.Error(),
+		})
+		return
+	}
+
+	c.Header("Location", "/api/uploads/"+upload.ID)
+	c.Header("Upload-Offset", "0")
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Upload created successfully",
+		"data":    upload,
+	})
+}
+
+// UploadStatus handles HEAD /api/uploads/:id, reporting how many bytes
+// have arrived
+func (h *UploadHandler) UploadStatus(c *gin.Context) {
+	c.Header("Tus-Resumable", tusVersion)
+	c.Header("Cache-Control", "no-store")
+
+	identity, _ := auth.FromContext(c.Request.Context())
+	upload, err := h.uploads.Get(identity.Subject, c.Param("id"))
+	if err != nil {
+		c.Status(uploadErrorStatus(err))
+		return
+	}
+
+	c.Header("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	c.Header("Upload-Length", strconv.FormatInt(upload.Length, 10))
+	c.Status(http.StatusOK)
+}
+
+// AppendChunk handles PATCH /api/uploads/:id
+//
+// The body is streamed to disk. Upload-Offset must equal the bytes
+// received so far; on a mismatch the response carries the current offset.
+func (h *UploadHandler) AppendChunk(c *gin.Context) {
+	c.Header("Tus-Resumable", tusVersion)
+
+	if c.ContentType() != "application/offset+octet-stream" {
+		c.JSON(http.StatusUnsupportedMediaType, models.ContentResponse{
+			Success: false,
+			Error:   "Chunks must be sent as application/offset+octet-stream",
+		})
+		return
+	}
+	offset, err := strconv.ParseInt(c.GetHeader("Upload-Offset"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   "Upload-Offset header is required",
+		})
+		return
+	}
+
+	identity, _ := auth.FromContext(c.Request.Context())
+	offset, err = h.uploads.Append(identity.Subject, c.Param("id"), offset, c.Request.Body)
+	if err != nil {
+		if offset > 0 {
+			c.Header("Upload-Offset", strconv.FormatInt(offset, 10))
+		}
+		c.JSON(uploadErrorStatus(err), models.ContentResponse{
+			Success: false,
+			Error:   "Failed to append chunk: " + err.Error(),
+		})
+		return
+	}
+
+	c.Header("Upload-Offset", strconv.FormatInt(offset, 10))
+	c.Status(http.StatusNoContent)
+}
+
+// TerminateUpload handles DELETE /api/uploads/:id
+func (h *UploadHandler) TerminateUpload(c *gin.Context) {
+	c.Header("Tus-Resumable", tusVersion)
+
+	identity, _ := auth.FromContext(c.Request.Context())
+	upload, err := h.uploads.Terminate(identity.Subject, c.Param("id"))
+	if err != nil {
+		c.JSON(uploadErrorStatus(err), models.ContentResponse{
+			Success: false,
+			Error:   "Failed to delete upload: " + err.Error(),
+		})
+		return
+	}
+	h.content.quotas.ReleaseStorage(ratelimit.UserKey(c), upload.Length, upload.QuotaDay)
+	c.Status(http.StatusNoContent)
+}
+
+// FinishUpload handles POST /api/uploads/:id/content
+//
+// The body is the content's metadata, as for POST /api/content without
+// ImageData. The upload is deleted once the content is created; its storage
+// stays charged.
+func (h *UploadHandler) FinishUpload(c *gin.Context) {
+	if !authorize(c, auth.ActionCreateContent, nil) {
+		return
+	}
+	identity, _ := auth.FromContext(c.Request.Context())
+
+	var meta models.ContentMetadata
+	if err := c.ShouldBindJSON(&meta); err != nil {
+		c.JSON(http.StatusBadRequest, models.ContentResponse{
+			Success: false,
+			Error:   "Invalid request data: " + err.Error(),
+		})
+		return
+	}
+
+	id := c.Param("id")
+	file, err := h.uploads.Finish(identity.Subject, id)
+	if err != nil {
+		c.JSON(uploadErrorStatus(err), models.ContentResponse{
+			Success: false,
+			Error:   "Failed to finish upload: " + err.Error(),
+		})
+		return
+	}
+
+	// The upload's length was charged to the quota when it was created
+	if h.content.createFromFile(c, meta, file, true) {
+		if _, err := h.uploads.Terminate(identity.Subject, id); err != nil {
+			// The content exists; the leftover upload expires on its own
+			c.Error(err)
+		}
+	}
+}
+
+// uploadErrorStatus maps an uploads error to its HTTP status
+func uploadErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, uploads.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, uploads.ErrOffsetMismatch), errors.Is(err, uploads.ErrIncomplete):
+		return http.StatusConflict
+	case errors.Is(err, uploads.ErrBusy):
+		return http.StatusLocked
+	case errors.Is(err, uploads.ErrExceedsLength), errors.Is(err, imaging.ErrTooLarge):
+		return http.StatusRequestEntityTooLarge
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// parseUploadMetadata decodes a tus Upload-Metadata header: comma-separated
+// pairs of a key and an optional base64 value
+func parseUploadMetadata(header string) (map[string]string, error) {
+	if strings.TrimSpace(header) == "" {
+		return nil, nil
+	}
+
+	metadata := make(map[string]string)
+	for _, pair := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		if key == "" {
+			return nil, errors.New("invalid Upload-Metadata header")
+		}
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, errors.New("invalid Upload-Metadata value for " + key)
+		}
+		metadata[key] = string(decoded)
+	}
+	return metadata, nil
+}
@@ -0,0 +1,241 @@
+// Package imaging validates uploaded images. It accepts raw base64 or data
+// URLs, sniffs the real format from the bytes rather than trusting a
+// declared type, and checks dimensions against configurable limits before
+// anything is stored.
+package imaging
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // register GIF decoding
+	_ "image/jpeg" // register JPEG decoding
+	_ "image/png"  // register PNG decoding
+	"io"
+	"strings"
+)
+
+// Supported formats
+const (
+	FormatPNG  = "png"
+	FormatJPEG = "jpeg"
+	FormatGIF  = "gif"
+	FormatWebP = "webp"
+)
+
+// Errors returned for rejected uploads
+var (
+	ErrInvalidEncoding = errors.New("image data must be base64 or a base64 data URL")
+	ErrUnsupported     = errors.New("unsupported image format; use PNG, JPEG, WebP or GIF")
+	ErrTooLarge        = errors.New("image exceeds the maximum size")
+	ErrTooManyPixels   = errors.New("image exceeds the maximum dimensions")
+	ErrCorrupt         = errors.New("image could not be decoded")
+)
+
+// Limits bounds what uploads may contain; zero fields are not enforced
+type Limits struct {
+	MaxBytes     int64 // encoded file size
+	MaxPixels    int64 // width * height
+	MaxDimension int   // longest side
+}
+
+// DefaultLimits fit any Stable Diffusion output with room to spare
+var DefaultLimits = Limits{
+	MaxBytes:     20 << 20,
+	MaxPixels:    40_000_000,
+	MaxDimension: 8192,
+}
+
+// Info describes a validated image
+type Info struct {
+	Format   string
+	MimeType string
+	Width    int
+	Height   int
+}
+
+// mimeTypes maps each format to its media type
+var mimeTypes = map[string]string{
+	FormatPNG:  "image/png",
+	FormatJPEG: "image/jpeg",
+	FormatGIF:  "image/gif",
+	FormatWebP: "image/webp",
+}
+
+// extensions maps media types to download file extensions
+var extensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// Extension returns the file extension for a media type, defaulting to
+// .png for records stored before types were detected
+func Extension(mimeType string) string {
+	if ext, ok := extensions[mimeType]; ok {
+		return ext
+	}
+	return ".png"
+}
+
+// DecodeData decodes raw base64 or a "data:<type>;base64," URL. Data that
+// would decode to more than maxBytes is rejected before decoding.
+func DecodeData(data string, maxBytes int64) ([]byte, error) {
+	data = strings.TrimSpace(data)
+	if strings.HasPrefix(data, "data:") {
+		header, payload, ok := strings.Cut(data, ",")
+		if !ok || !strings.HasSuffix(header, ";base64") {
+			return nil, ErrInvalidEncoding
+		}
+		data = payload
+	}
+
+	if maxBytes > 0 && int64(base64.StdEncoding.DecodedLen(len(data))) > maxBytes+2 {
+		return nil, ErrTooLarge
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		// Some clients strip the padding
+		if decoded, err = base64.RawStdEncoding.DecodeString(strings.TrimRight(data, "=")); err != nil {
+			return nil, ErrInvalidEncoding
+		}
+	}
+	if maxBytes > 0 && int64(len(decoded)) > maxBytes {
+		return nil, ErrTooLarge
+	}
+	return decoded, nil
+}
+
+// Sniff identifies the image format from its magic bytes, or returns ""
+func Sniff(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return FormatPNG
+	case bytes.HasPrefix(data, []byte("\xff\xd8\xff")):
+		return FormatJPEG
+	case bytes.HasPrefix(data, []byte("GIF87a")), bytes.HasPrefix(data, []byte("GIF89a")):
+		return FormatGIF
+	case len(data) >= 12 && string(data[:4]) == "RIFF" && string(data[8:12]) == "WEBP":
+		return FormatWebP
+	default:
+		return ""
+	}
+}
+
+// MimeType returns the media type of data, or "" if it is not a supported
+// image
+func MimeType(data []byte) string {
+	return mimeTypes[Sniff(data)]
+}
+
+// Inspect validates an image against limits and returns what it is. PNG,
+// JPEG and GIF are fully decoded, so truncated or corrupt files are
+// rejected; WebP, which the standard library cannot decode, is checked
+// from its headers.
+func Inspect(data []byte, limits Limits) (Info, error) {
+	return InspectReader(bytes.NewReader(data), int64(len(data)), limits)
+}
+
+// InspectReader is Inspect for an image of size bytes read from r, such as
+// an upload staged on disk
+func InspectReader(r io.ReadSeeker, size int64, limits Limits) (Info, error) {
+	if limits.MaxBytes > 0 && size > limits.MaxBytes {
+		return Info{}, ErrTooLarge
+	}
+
+	// Every supported format identifies itself in its first 30 bytes
+	head := make([]byte, 32)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return Info{}, ErrCorrupt
+	}
+	head = head[:n]
+
+	format := Sniff(head)
+	if format == "" {
+		return Info{}, ErrUnsupported
+	}
+	info := Info{Format: format, MimeType: mimeTypes[format]}
+
+	if format == FormatWebP {
+		if info.Width, info.Height, err = webpSize(head); err != nil {
+			return Info{}, err
+		}
+		return info, checkDimensions(info, limits)
+	}
+
+	// Check the header before decoding so a small file cannot claim a huge
+	// canvas and exhaust memory
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return Info{}, err
+	}
+	config, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return Info{}, ErrCorrupt
+	}
+	info.Width, info.Height = config.Width, config.Height
+	if err := checkDimensions(info, limits); err != nil {
+		return Info{}, err
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return Info{}, err
+	}
+	if _, _, err := image.Decode(r); err != nil {
+		return Info{}, ErrCorrupt
+	}
+	return info, nil
+}
+
+// checkDimensions applies the pixel limits
+func checkDimensions(info Info, limits Limits) error {
+	if info.Width <= 0 || info.Height <= 0 {
+		return ErrCorrupt
+	}
+	if limits.MaxDimension > 0 && (info.Width > limits.MaxDimension || info.Height > limits.MaxDimension) {
+		return fmt.Errorf("%w: %dx%d, longest side may be %d", ErrTooManyPixels, info.Width, info.Height, limits.MaxDimension)
+	}
+	if limits.MaxPixels > 0 && int64(info.Width)*int64(info.Height) > limits.MaxPixels {
+		return fmt.Errorf("%w: %dx%d, at most %d pixels", ErrTooManyPixels, info.Width, info.Height, limits.MaxPixels)
+	}
+	return nil
+}
+
+// webpSize reads the canvas size from the first chunk of a WebP file:
+// VP8 (lossy), VP8L (lossless) or VP8X (extended)
+func webpSize(data []byte) (int, int, error) {
+	if len(data) < 30 {
+		return 0, 0, ErrCorrupt
+	}
+	chunk := data[20:]
+
+	switch string(data[12:16]) {
+	case "VP8 ":
+		// 3-byte frame tag, then the start code and two 14-bit dimensions
+		if !bytes.Equal(chunk[3:6], []byte{0x9d, 0x01, 0x2a}) {
+			return 0, 0, ErrCorrupt
+		}
+		w := int(binary.LittleEndian.Uint16(chunk[6:8]) & 0x3fff)
+		h := int(binary.LittleEndian.Uint16(chunk[8:10]) & 0x3fff)
+		return w, h, nil
+	case "VP8L":
+		// Signature byte, then 14-bit width-1 and height-1
+		if chunk[0] != 0x2f {
+			return 0, 0, ErrCorrupt
+		}
+		bits := binary.LittleEndian.Uint32(chunk[1:5])
+		return int(bits&0x3fff) + 1, int(bits>>14&0x3fff) + 1, nil
+	case "VP8X":
+		// Flags and reserved bytes, then 24-bit width-1 and height-1
+		w := int(chunk[4]) | int(chunk[5])<<8 | int(chunk[6])<<16
+		h := int(chunk[7]) | int(chunk[8])<<8 | int(chunk[9])<<16
+		return w + 1, h + 1, nil
+	default:
+		return 0, 0, ErrCorrupt
+	}
+}
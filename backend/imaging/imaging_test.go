@@ -0,0 +1,105 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func encode(t *testing.T, format string, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case FormatPNG:
+		err = png.Encode(&buf, img)
+	case FormatJPEG:
+		err = jpeg.Encode(&buf, img, nil)
+	case FormatGIF:
+		err = gif.Encode(&buf, img, nil)
+	}
+	if err != nil {
+		t.Fatalf("encode %s: %v", format, err)
+	}
+	return buf.Bytes()
+}
+
+// losslessWebP returns the headers of a VP8L file of the given size
+func losslessWebP(w, h int) []byte {
+	chunk := make([]byte, 10)
+	chunk[0] = 0x2f
+	binary.LittleEndian.PutUint32(chunk[1:5], uint32(w-1)|uint32(h-1)<<14)
+
+	var buf bytes.Buffer
+	buf.WriteString("RIFF")
+	binary.Write(&buf, binary.LittleEndian, uint32(12+len(chunk)))
+	buf.WriteString("WEBPVP8L")
+	binary.Write(&buf, binary.LittleEndian, uint32(len(chunk)))
+	buf.Write(chunk)
+	return buf.Bytes()
+}
+
+func TestInspect(t *testing.T) {
+	for _, format := range []string{FormatPNG, FormatJPEG, FormatGIF} {
+		info, err := Inspect(encode(t, format, 32, 24), DefaultLimits)
+		if err != nil || info.Format != format || info.Width != 32 || info.Height != 24 {
+			t.Errorf("Inspect(%s) = %+v, %v", format, info, err)
+		}
+	}
+
+	info, err := Inspect(losslessWebP(640, 480), DefaultLimits)
+	if err != nil || info.MimeType != "image/webp" || info.Width != 640 || info.Height != 480 {
+		t.Fatalf("Inspect(webp) = %+v, %v", info, err)
+	}
+}
+
+func TestInspectRejects(t *testing.T) {
+	img := encode(t, FormatPNG, 100, 100)
+
+	cases := []struct {
+		name   string
+		data   []byte
+		limits Limits
+		want   error
+	}{
+		{"text", []byte("not an image at all"), DefaultLimits, ErrUnsupported},
+		{"truncated", img[:len(img)/2], DefaultLimits, ErrCorrupt},
+		{"bytes", img, Limits{MaxBytes: 10}, ErrTooLarge},
+		{"pixels", img, Limits{MaxPixels: 100*100 - 1}, ErrTooManyPixels},
+		{"side", losslessWebP(9000, 10), DefaultLimits, ErrTooManyPixels},
+	}
+	for _, tc := range cases {
+		if _, err := Inspect(tc.data, tc.limits); !errors.Is(err, tc.want) {
+			t.Errorf("%s: err = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+}
+
+func TestDecodeData(t *testing.T) {
+	raw := []byte("image bytes!")
+	b64 := base64.StdEncoding.EncodeToString(raw)
+
+	for _, data := range []string{b64, "data:image/png;base64," + b64, base64.RawStdEncoding.EncodeToString(raw)} {
+		got, err := DecodeData(data, 0)
+		if err != nil || !bytes.Equal(got, raw) {
+			t.Errorf("DecodeData(%q) = %q, %v", data, got, err)
+		}
+	}
+
+	if _, err := DecodeData("data:image/png,"+b64, 0); err != ErrInvalidEncoding {
+		t.Errorf("non-base64 data URL: %v", err)
+	}
+	if _, err := DecodeData("%%%", 0); err != ErrInvalidEncoding {
+		t.Errorf("invalid base64: %v", err)
+	}
+	if _, err := DecodeData(b64, 4); err != ErrTooLarge {
+		t.Errorf("oversized: %v", err)
+	}
+}
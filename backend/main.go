@@ -1,47 +1,231 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"licenz-backend/auth"
+	"licenz-backend/blobstore"
+	"licenz-backend/database"
+	"licenz-backend/derivatives"
 	"licenz-backend/handlers"
+	"licenz-backend/imaging"
+	"licenz-backend/ratelimit"
+	"licenz-backend/search"
+	"licenz-backend/services"
+	"licenz-backend/uploads"
 )
 
 func main() {
 	// Set Gin to release mode in production
 	gin.SetMode(gin.ReleaseMode)
 
+	// Open the content store selected by DB_BACKEND (json, persistent, memory or sqlite)
+	store, err := database.Open(database.Config{
+		Backend: os.Getenv("DB_BACKEND"),
+		Path:    os.Getenv("DB_PATH"),
+	})
+	if err != nil {
+		log.Fatalf("❌ Failed to open database: %v", err)
+	}
+
+	// Image bytes live in a content-addressed blob store under BLOB_DIR
+	blobDir := os.Getenv("BLOB_DIR")
+	if blobDir == "" {
+		blobDir = "data/blobs"
+	}
+	blobs, err := blobstore.NewFilesystemStore(blobDir)
+	if err != nil {
+		log.Fatalf("❌ Failed to open blob store: %v", err)
+	}
+
+	// Keep a full-text index in step with every write to the store
+	indexed, err := search.NewIndexedStore(store)
+	if err != nil {
+		log.Fatalf("❌ Failed to build search index: %v", err)
+	}
+
+	// Throttle the expensive routes per caller; limits come from RATE_LIMIT_*
+	// and QUOTA_* so they can be tuned without a rebuild
+	limits, quotas, err := newLimits()
+	if err != nil {
+		log.Fatalf("❌ Invalid rate limit configuration: %v", err)
+	}
+
+	imageLimits, err := newImageLimits()
+	if err != nil {
+		log.Fatalf("❌ Invalid image limits: %v", err)
+	}
+
+	// Thumbnails are rendered by a worker pool into THUMBNAIL_DIR
+	thumbs, err := newThumbnails(blobs, imageLimits)
+	if err != nil {
+		log.Fatalf("❌ Failed to configure thumbnails: %v", err)
+	}
+
+	// Uploads are received into UPLOAD_DIR; resumable ones stay there until
+	// finished or expired
+	uploadManager, err := newUploads(imageLimits)
+	if err != nil {
+		log.Fatalf("❌ Failed to open upload directory: %v", err)
+	}
+
+	content := handlers.NewContentHandler(indexed, blobs, indexed.Index(), quotas, imageLimits, thumbs, uploadManager.StageDir())
+	uploadHandler := handlers.NewUploadHandler(uploadManager, content)
+	generation := handlers.NewGenerationHandler(quotas)
+
+	// Bearer tokens from AUTH_TOKENS (token=user,...) identify callers
+	tokens, err := auth.ParseStaticTokens(os.Getenv("AUTH_TOKENS"))
+	if err != nil {
+		log.Fatalf("❌ Invalid AUTH_TOKENS: %v", err)
+	}
+
+	// Wallet logins (Sign-In with Ethereum) are exchanged for session tokens
+	sessions, err := newSessions()
+	if err != nil {
+		log.Fatalf("❌ Failed to configure sessions: %v", err)
+	}
+	policy := auth.SIWEPolicy{Domain: os.Getenv("SIWE_DOMAIN")}
+	if policy.Domain == "" {
+		log.Println("⚠️ SIWE_DOMAIN is not set; wallet sign-in is disabled")
+	}
+	if chainID := os.Getenv("SIWE_CHAIN_ID"); chainID != "" {
+		if policy.ChainID, err = strconv.ParseInt(chainID, 10, 64); err != nil {
+			log.Fatalf("❌ Invalid SIWE_CHAIN_ID: %v", err)
+		}
+	}
+	authHandler := handlers.NewAuthHandler(auth.NewNonceStore(), sessions, policy)
+
+	// Scoped API keys (Bearer lz_...) for server-to-server clients
+	apiKeyPath := os.Getenv("API_KEYS_PATH")
+	if apiKeyPath == "" {
+		apiKeyPath = auth.DefaultAPIKeyPath
+	}
+	apiKeys, err := auth.NewAPIKeyStore(apiKeyPath)
+	if err != nil {
+		log.Fatalf("❌ Failed to load API keys: %v", err)
+	}
+	keys := handlers.NewAPIKeyHandler(apiKeys)
+
+	// Roles: everyone signed in is a creator unless an admin says otherwise;
+	// ADMIN_SUBJECTS (comma-separated) are always admins
+	userPath := os.Getenv("USERS_PATH")
+	if userPath == "" {
+		userPath = auth.DefaultUserPath
+	}
+	users, err := auth.NewUserStore(userPath, strings.Split(os.Getenv("ADMIN_SUBJECTS"), ","))
+	if err != nil {
+		log.Fatalf("❌ Failed to load users: %v", err)
+	}
+	admin := handlers.NewAdminHandler(indexed, users)
+
+	// Licenses live in the LicenZLicense contract at LICENZ_LICENSE_CONTRACT,
+	// reached through ETH_RPC_URL; without them the license routes answer 503
+	licenseService, err := newLicenseService()
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to the license contract: %v", err)
+	}
+	licenses := handlers.NewLicenseHandler(licenseService, indexed)
+
+	// Tokens are minted by the LicenZNFT contract at LICENZ_NFT_CONTRACT. With
+	// NFT_MINTER_KEY the server mints itself; without it, users' wallets do.
+	nftService, err := newNFTService()
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to the NFT contract: %v", err)
+	}
+	nfts := handlers.NewNFTHandler(nftService, indexed)
+
 	// Create a new Gin router
 	r := gin.Default()
 
 	// Configure CORS for frontend communication
 	r.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"http://localhost:5173", "http://localhost:5174", "http://localhost:5175", "http://localhost:5176"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowMethods:     []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "If-Match", "If-None-Match", "If-Modified-Since", "If-Range", "Range", "Upload-Length", "Upload-Offset", "Upload-Metadata", "Tus-Resumable"},
+		ExposeHeaders:    []string{"Content-Length", "Content-Range", "Content-Disposition", "Accept-Ranges", "ETag", "Last-Modified", "Location", "Retry-After", "X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset", "Upload-Offset", "Upload-Length", "Tus-Resumable", "Tus-Version", "Tus-Extension", "Tus-Max-Size"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
 
 	// API routes group
-	api := r.Group("/api")
+	api := r.Group("/api", auth.Middleware(tokens, apiKeys, sessions), users.AttachRoles())
 	{
-			// Content management (Persistent Storage)
-	api.POST("/content", handlers.CreateContent)
-	api.GET("/content", handlers.GetAllContent)
-	api.GET("/content/:id", handlers.GetContentByID)
-	api.DELETE("/content/:id", handlers.DeleteContent)
-	api.GET("/content/:id/download", handlers.DownloadContent)
-	api.GET("/content/search", handlers.SearchContent)
-	api.GET("/content/stats", handlers.GetContentStats)
+		// Wallet login
+		signIn := api.Group("/auth", authHandler.Available, ratelimit.Middleware(limits.auth))
+		signIn.GET("/nonce", authHandler.Nonce)
+		signIn.POST("/verify", authHandler.Verify)
+		api.GET("/auth/me", auth.RequireAuth(), authHandler.Me)
+
+		// API key management; keys need the admin scope to manage keys
+		keyAdmin := api.Group("/keys", auth.RequireAuth(), auth.RequireScope(auth.ScopeAdmin))
+		keyAdmin.POST("", keys.CreateKey)
+		keyAdmin.GET("", keys.ListKeys)
+		keyAdmin.DELETE("/:id", keys.RevokeKey)
+
+		// Operator surface: admin role, and the admin scope for API keys
+		adminGroup := api.Group("/admin", auth.RequireAuth(), auth.RequireScope(auth.ScopeAdmin), auth.RequireRole(auth.RoleAdmin))
+		adminGroup.GET("/users", admin.ListUsers)
+		adminGroup.PUT("/users/:subject/role", admin.SetUserRole)
+		adminGroup.POST("/backup", admin.Backup)
+
+		// Content management. Reads are public, but an API key must carry
+		// content:read to use them.
+		read := api.Group("", auth.RequireScope(auth.ScopeContentRead))
+		read.GET("/content", content.GetAllContent)
+		read.GET("/content/:id", content.GetContentByID)
+		read.GET("/content/:id/download", content.DownloadContent)
+		read.HEAD("/content/:id/download", content.DownloadContent)
+		read.GET("/content/:id/thumbnail", content.GetThumbnail)
+		read.GET("/content/search", ratelimit.Middleware(limits.search), content.SearchContent)
+		read.GET("/content/stats", content.GetContentStats)
+
+		write := api.Group("", auth.RequireAuth(), auth.RequireScope(auth.ScopeContentWrite))
+		write.POST("/content", ratelimit.Middleware(limits.create), content.CreateContent)
+		write.PATCH("/content/:id", content.PatchContent)
+		write.DELETE("/content/:id", content.DeleteContent)
+
+		// Resumable uploads for images too large to send in one request
+		api.OPTIONS("/uploads", uploadHandler.Options)
+		write.POST("/uploads", ratelimit.Middleware(limits.create), uploadHandler.CreateUpload)
+		write.HEAD("/uploads/:id", uploadHandler.UploadStatus)
+		write.PATCH("/uploads/:id", uploadHandler.AppendChunk)
+		write.DELETE("/uploads/:id", uploadHandler.TerminateUpload)
+		write.POST("/uploads/:id/content", ratelimit.Middleware(limits.create), uploadHandler.FinishUpload)
+
+		// On-chain licenses. Writes are answered with unsigned transactions
+		// for the user's wallet.
+		licenseRead := read.Group("/licenses", licenses.Available)
+		licenseRead.GET("", licenses.ListLicenses)
+		licenseRead.GET("/:id", licenses.GetLicense)
+		licenseRead.GET("/:id/valid", licenses.VerifyLicense)
+		licenseWrite := write.Group("/licenses", licenses.Available)
+		licenseWrite.POST("", licenses.CreateLicense)
+		licenseWrite.POST("/confirm", licenses.ConfirmLicense)
+		licenseWrite.POST("/:id/purchase", licenses.PurchaseLicense)
+		licenseWrite.POST("/:id/deactivate", licenses.DeactivateLicense)
+
+		// NFT minting and token lookups
+		read.GET("/nfts", nfts.Available, nfts.GetNFTByContentHash)
+		read.GET("/nfts/:id", nfts.Available, nfts.GetNFT)
+		write.POST("/content/:id/mint", nfts.Available, nfts.MintContent)
+		write.POST("/content/:id/mint/confirm", nfts.Available, nfts.ConfirmMint)
 
 		// AI generation tracking
-		api.POST("/generate", handlers.TrackGeneration)
-		api.GET("/generate/history", handlers.GetGenerationHistory)
+		generate := api.Group("", auth.RequireScope(auth.ScopeGenerate))
+		generate.POST("/generate", ratelimit.Middleware(limits.generate), generation.TrackGeneration)
+		generate.GET("/generate/history", generation.GetGenerationHistory)
 
 		// Health and status
 		api.GET("/health", healthCheck)
@@ -67,7 +251,209 @@ func main() {
 	log.Println("🚀 Starting LicenZ backend server on port 8080...")
 	log.Println("📡 Server will be available at: http://localhost:8080")
 	log.Println("🔗 API endpoints available at: http://localhost:8080/api")
-	log.Fatal(r.Run(":8080"))
+	srv := &http.Server{Addr: ":8080", Handler: r}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("❌ Server failed: %v", err)
+		}
+	}()
+
+	// On SIGINT or SIGTERM, finish in-flight requests, let the thumbnail
+	// workers drain their queue, then close the store so file backends
+	// flush and compact
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	<-stop
+	log.Println("🛑 Shutting down...")
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("⚠️ Server shutdown: %v", err)
+	}
+	thumbs.Close()
+	if closer, ok := store.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			log.Printf("⚠️ Failed to close content store: %v", err)
+		}
+	}
+}
+
+// newSessions configures session tokens from SESSION_SECRET and SESSION_TTL
+func newSessions() (*auth.Sessions, error) {
+	secret := []byte(os.Getenv("SESSION_SECRET"))
+	if len(secret) == 0 {
+		log.Println("⚠️ SESSION_SECRET is not set; sessions will not survive a restart")
+		var err error
+		if secret, err = auth.RandomSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	var ttl time.Duration
+	if value := os.Getenv("SESSION_TTL"); value != "" {
+		var err error
+		if ttl, err = time.ParseDuration(value); err != nil {
+			return nil, err
+		}
+	}
+
+	return auth.NewSessions(secret, ttl)
+}
+
+// newLicenseService connects to the LicenZLicense contract configured by
+// ETH_RPC_URL and LICENZ_LICENSE_CONTRACT, or returns nil if either is unset
+func newLicenseService() (*services.LicenseService, error) {
+	rpcURL, address := os.Getenv("ETH_RPC_URL"), os.Getenv("LICENZ_LICENSE_CONTRACT")
+	if rpcURL == "" || address == "" {
+		log.Println("⚠️ ETH_RPC_URL or LICENZ_LICENSE_CONTRACT is not set; licensing is disabled")
+		return nil, nil
+	}
+	service, err := services.NewLicenseService(rpcURL, address)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("📜 Licenses: LicenZLicense at %s", service.ContractAddress().Hex())
+	return service, nil
+}
+
+// newNFTService connects to the LicenZNFT contract configured by ETH_RPC_URL
+// and LICENZ_NFT_CONTRACT, or returns nil if either is unset. NFT_MINTER_KEY
+// is optional and enables custodial minting.
+func newNFTService() (*services.NFTService, error) {
+	rpcURL, address := os.Getenv("ETH_RPC_URL"), os.Getenv("LICENZ_NFT_CONTRACT")
+	if rpcURL == "" || address == "" {
+		log.Println("⚠️ ETH_RPC_URL or LICENZ_NFT_CONTRACT is not set; NFT minting is disabled")
+		return nil, nil
+	}
+	service, err := services.NewNFTService(rpcURL, address, os.Getenv("NFT_MINTER_KEY"))
+	if err != nil {
+		return nil, err
+	}
+	mode := "wallet mints only"
+	if service.Custodial() {
+		mode = "custodial minting enabled"
+	}
+	log.Printf("🪙 NFTs: LicenZNFT at %s, %s", service.ContractAddress().Hex(), mode)
+	return service, nil
+}
+
+// routeLimits holds the per-route rate limiters
+type routeLimits struct {
+	create, generate, search, auth *ratelimit.Limiter
+}
+
+// newLimits configures rate limits from RATE_LIMIT_CREATE,
+// RATE_LIMIT_GENERATE, RATE_LIMIT_SEARCH and RATE_LIMIT_AUTH
+// ("requests/period" or "off"),
+// and daily quotas from QUOTA_STORAGE_MB and QUOTA_GENERATIONS (0 = none)
+func newLimits() (routeLimits, *ratelimit.Quotas, error) {
+	var limits routeLimits
+	for _, route := range []struct {
+		env     string
+		def     ratelimit.Limit
+		limiter **ratelimit.Limiter
+	}{
+		{"RATE_LIMIT_CREATE", ratelimit.Limit{Requests: 30, Period: time.Minute}, &limits.create},
+		{"RATE_LIMIT_GENERATE", ratelimit.Limit{Requests: 10, Period: time.Minute}, &limits.generate},
+		{"RATE_LIMIT_SEARCH", ratelimit.Limit{Requests: 120, Period: time.Minute}, &limits.search},
+		{"RATE_LIMIT_AUTH", ratelimit.Limit{Requests: 20, Period: time.Minute}, &limits.auth},
+	} {
+		limit, err := ratelimit.ParseLimit(os.Getenv(route.env), route.def)
+		if err != nil {
+			return routeLimits{}, nil, fmt.Errorf("%s: %v", route.env, err)
+		}
+		*route.limiter = ratelimit.NewLimiter(limit)
+	}
+
+	quota := ratelimit.Quota{StorageBytes: 500 << 20, Generations: 200}
+	if value := os.Getenv("QUOTA_STORAGE_MB"); value != "" {
+		mb, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || mb < 0 {
+			return routeLimits{}, nil, fmt.Errorf("QUOTA_STORAGE_MB: invalid value %q", value)
+		}
+		quota.StorageBytes = mb << 20
+	}
+	if value := os.Getenv("QUOTA_GENERATIONS"); value != "" {
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || n < 0 {
+			return routeLimits{}, nil, fmt.Errorf("QUOTA_GENERATIONS: invalid value %q", value)
+		}
+		quota.Generations = n
+	}
+
+	log.Printf("🚦 Rate limits: create %s, generate %s, search %s, sign-in %s; daily quotas: %d MB, %d generations",
+		limits.create.Limit(), limits.generate.Limit(), limits.search.Limit(), limits.auth.Limit(), quota.StorageBytes>>20, quota.Generations)
+	return limits, ratelimit.NewQuotas(quota), nil
+}
+
+// newImageLimits reads upload limits from IMAGE_MAX_MB and IMAGE_MAX_PIXELS,
+// falling back to imaging.DefaultLimits
+func newImageLimits() (imaging.Limits, error) {
+	limits := imaging.DefaultLimits
+	if value := os.Getenv("IMAGE_MAX_MB"); value != "" {
+		mb, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || mb <= 0 {
+			return imaging.Limits{}, fmt.Errorf("IMAGE_MAX_MB: invalid value %q", value)
+		}
+		limits.MaxBytes = mb << 20
+	}
+	if value := os.Getenv("IMAGE_MAX_PIXELS"); value != "" {
+		pixels, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || pixels <= 0 {
+			return imaging.Limits{}, fmt.Errorf("IMAGE_MAX_PIXELS: invalid value %q", value)
+		}
+		limits.MaxPixels = pixels
+	}
+	return limits, nil
+}
+
+// newUploads opens the upload directory UPLOAD_DIR, allowing each user
+// UPLOAD_MAX_OPEN unfinished resumable uploads
+func newUploads(limits imaging.Limits) (*uploads.Manager, error) {
+	dir := os.Getenv("UPLOAD_DIR")
+	if dir == "" {
+		dir = "data/uploads"
+	}
+
+	maxOpen := 5
+	if value := os.Getenv("UPLOAD_MAX_OPEN"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("UPLOAD_MAX_OPEN: invalid value %q", value)
+		}
+		maxOpen = n
+	}
+	return uploads.NewManager(dir, limits.MaxBytes, maxOpen, uploads.DefaultTTL)
+}
+
+// newThumbnails starts the thumbnail pool configured by THUMBNAIL_DIR,
+// THUMBNAIL_SIZES (e.g. "256,1024"), THUMBNAIL_QUALITY and THUMBNAIL_WORKERS.
+// Images are decoded under the same limits as uploads.
+func newThumbnails(blobs blobstore.Store, limits imaging.Limits) (*derivatives.Pool, error) {
+	dir := os.Getenv("THUMBNAIL_DIR")
+	if dir == "" {
+		dir = "data/thumbnails"
+	}
+
+	sizes := derivatives.DefaultSizes
+	if value := os.Getenv("THUMBNAIL_SIZES"); value != "" {
+		var err error
+		if sizes, err = derivatives.ParseSizes(value); err != nil {
+			return nil, err
+		}
+	}
+
+	quality, _ := strconv.Atoi(os.Getenv("THUMBNAIL_QUALITY"))
+	workers, _ := strconv.Atoi(os.Getenv("THUMBNAIL_WORKERS"))
+	if workers <= 0 {
+		workers = 2
+	}
+
+	gen, err := derivatives.NewGenerator(blobs, dir, sizes, quality, limits)
+	if err != nil {
+		return nil, err
+	}
+	return derivatives.NewPool(gen, workers, 256), nil
 }
 
 // Health check endpoint
@@ -89,6 +475,8 @@ func getStatus(c *gin.Context) {
 		"timestamp": time.Now().UTC(),
 		"endpoints": gin.H{
 			"content":    "/api/content",
+			"licenses":   "/api/licenses",
+			"nfts":       "/api/nfts",
 			"generate":   "/api/generate",
 			"health":     "/api/health",
 			"status":     "/api/status",
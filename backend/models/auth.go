@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+)
+
+// NonceResponse carries a fresh Sign-In with Ethereum nonce
+type NonceResponse struct {
+	Success bool   `json:"success"`
+	Nonce   string `json:"nonce,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// SIWEVerifyRequest is a signed EIP-4361 message submitted for login
+type SIWEVerifyRequest struct {
+	Message   string `json:"message" binding:"required"`
+	Signature string `json:"signature" binding:"required"`
+}
+
+// SessionResponse carries a session token after a successful login
+type SessionResponse struct {
+	Success   bool      `json:"success"`
+	Message   string    `json:"message,omitempty"`
+	Token     string    `json:"token,omitempty"`
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	Address   string    `json:"address,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// CreateAPIKeyRequest asks for a new API key
+type CreateAPIKeyRequest struct {
+	Name   string   `json:"name" binding:"required"`
+	Scopes []string `json:"scopes" binding:"required"`
+}
+
+// APIKeyInfo describes an API key without its secret
+type APIKeyInfo struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Owner      string     `json:"owner"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// APIKeyResponse represents the response for a single API key. Token is
+// only set when the key is created.
+type APIKeyResponse struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message,omitempty"`
+	Data    *APIKeyInfo `json:"data,omitempty"`
+	Token   string      `json:"token,omitempty"`
+	Error   string      `json:"error,omitempty"`
+}
+
+// APIKeyListResponse represents the response for API key listing
+type APIKeyListResponse struct {
+	Success bool         `json:"success"`
+	Data    []APIKeyInfo `json:"data"`
+	Total   int          `json:"total"`
+	Error   string       `json:"error,omitempty"`
+}
+
+// SetRoleRequest assigns a role to a user
+type SetRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
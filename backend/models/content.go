@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strconv"
 	"time"
 )
 
@@ -10,8 +11,15 @@ type Content struct {
 	Prompt      string    `json:"prompt" bson:"prompt"`
 	Style       string    `json:"style" bson:"style"`
 	ImageURL    string    `json:"ImageURL" bson:"image_url"`
-	ImageData   string    `json:"ImageData" bson:"image_data"` // Base64 encoded image
-	ContentHash string    `json:"ContentHash" bson:"content_hash"`
+	ImageData   string    `json:"ImageData,omitempty" bson:"image_data,omitempty"` // Base64 image, legacy records only
+	ContentHash string    `json:"ContentHash" bson:"content_hash"` // 0x SHA-256 of the image, verified on upload
+	ContentKeccak string  `json:"content_keccak,omitempty" bson:"content_keccak,omitempty"` // 0x keccak256 of the image
+	
+	// Image blob reference (SHA-256 key in the blob store)
+	ImageBlob   string `json:"image_blob,omitempty" bson:"image_blob,omitempty"`
+	ImageSize   int64  `json:"image_size,omitempty" bson:"image_size,omitempty"`
+	MimeType    string `json:"mime_type,omitempty" bson:"mime_type,omitempty"`
+	Thumbnails  map[string]string `json:"thumbnails,omitempty" bson:"-"` // size -> URL, filled in by the API, not stored
 	Seed        int64     `json:"seed" bson:"seed,omitempty"`
 	
 	// Generation parameters
@@ -35,13 +43,27 @@ type Content struct {
 	LicenseType string `json:"license_type" bson:"license_type,omitempty"`
 	NFTMinted   bool   `json:"nft_minted" bson:"nft_minted"`
 	NFTTokenID  string `json:"nft_token_id" bson:"nft_token_id,omitempty"`
+
+	// Moderation: hidden content is withheld from everyone but its owner
+	// and moderators
+	Hidden bool `json:"hidden" bson:"hidden"`
+
+	// Version is bumped on every update, for optimistic concurrency
+	Version int64 `json:"version" bson:"version"`
 }
 
-// CreateContentRequest represents the request to create new content
+// CreateContentRequest represents the request to create new content with
+// the image inline
 type CreateContentRequest struct {
+	ContentMetadata
+	ImageData string `json:"ImageData" binding:"required"`
+}
+
+// ContentMetadata describes new content whose image is sent separately, as
+// a multipart part or a resumable upload
+type ContentMetadata struct {
 	Prompt      string  `json:"prompt" binding:"required"`
 	Style       string  `json:"style" binding:"required"`
-	ImageData   string  `json:"ImageData" binding:"required"`
 	ContentHash string  `json:"ContentHash" binding:"required"`
 	Seed        int64   `json:"seed,omitempty"`
 	CFGScale    float64 `json:"CFGScale"`
@@ -49,7 +71,7 @@ type CreateContentRequest struct {
 	Height      int     `json:"height"`
 	Width       int     `json:"width"`
 	Model       string  `json:"model"`
-	UserID      string  `json:"UserID,omitempty"`
+	UserID      string  `json:"UserID,omitempty"` // Ignored: the owner is the authenticated caller
 }
 
 // ContentResponse represents the response for content operations
@@ -62,11 +84,71 @@ type ContentResponse struct {
 
 // ContentListResponse represents the response for content listing
 type ContentListResponse struct {
-	Success bool      `json:"success"`
-	Message string    `json:"message,omitempty"`
-	Data    []Content `json:"data,omitempty"`
-	Total   int       `json:"total"`
-	Error   string    `json:"error,omitempty"`
+	Success    bool      `json:"success"`
+	Message    string    `json:"message,omitempty"`
+	Data       []Content `json:"data,omitempty"`
+	Total      int       `json:"total"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+	Facets     *Facets   `json:"facets,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// Facets counts content per value of each filterable field, over every
+// item matching a query rather than just the returned page. Empty string
+// values are not counted.
+type Facets struct {
+	Style       map[string]int `json:"style"`
+	Model       map[string]int `json:"model"`
+	IsLicensed  map[string]int `json:"is_licensed"`
+	NFTMinted   map[string]int `json:"nft_minted"`
+	LicenseType map[string]int `json:"license_type"`
+	Creator     map[string]int `json:"creator"`
+}
+
+// NewFacets creates empty facet counts
+func NewFacets() *Facets {
+	return &Facets{
+		Style:       make(map[string]int),
+		Model:       make(map[string]int),
+		IsLicensed:  make(map[string]int),
+		NFTMinted:   make(map[string]int),
+		LicenseType: make(map[string]int),
+		Creator:     make(map[string]int),
+	}
+}
+
+// Add counts one content item
+func (f *Facets) Add(content *Content) {
+	countValue(f.Style, content.Style)
+	countValue(f.Model, content.Model)
+	countValue(f.IsLicensed, strconv.FormatBool(content.IsLicensed))
+	countValue(f.NFTMinted, strconv.FormatBool(content.NFTMinted))
+	countValue(f.LicenseType, content.LicenseType)
+	countValue(f.Creator, content.UserID)
+}
+
+// countValue increments counts[value] unless value is empty
+func countValue(counts map[string]int, value string) {
+	if value != "" {
+		counts[value]++
+	}
+}
+
+// SearchResult is a content item matched by a full-text search
+type SearchResult struct {
+	Content
+	Score   float64 `json:"score"`
+	Snippet string  `json:"snippet"` // HTML-escaped prompt excerpt, matches wrapped in <mark></mark>
+}
+
+// SearchResponse represents the response for content search
+type SearchResponse struct {
+	Success bool           `json:"success"`
+	Message string         `json:"message,omitempty"`
+	Data    []SearchResult `json:"data,omitempty"`
+	Total   int            `json:"total"`
+	Facets  *Facets        `json:"facets,omitempty"`
+	Error   string         `json:"error,omitempty"`
 }
 
 // GenerationRequest represents an AI generation request
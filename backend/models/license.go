@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+)
+
+// License is an on-chain LicenZLicense record. Wei amounts are decimal
+// strings, since they do not fit in a JavaScript number.
+type License struct {
+	ID          string     `json:"id"`
+	ContentHash string     `json:"content_hash"`
+	PriceWei    string     `json:"price_wei"`
+	Terms       string     `json:"terms"`
+	Creator     string     `json:"creator"`
+	IsActive    bool       `json:"is_active"`
+	IsPurchased bool       `json:"is_purchased"`
+	Purchaser   string     `json:"purchaser,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	PurchasedAt *time.Time `json:"purchased_at,omitempty"`
+	Valid       bool       `json:"valid"` // active and not yet purchased
+}
+
+// CreateLicenseRequest asks for a createLicense transaction for content the
+// caller owns
+type CreateLicenseRequest struct {
+	ContentHash string `json:"content_hash" binding:"required"`
+	PriceWei    string `json:"price_wei" binding:"required"`
+	Terms       string `json:"terms" binding:"required"`
+	From        string `json:"from,omitempty"` // Wallet to send from; defaults to the caller's
+}
+
+// WalletRequest names the wallet a transaction is prepared for. It may be
+// left out by callers who signed in with that wallet.
+type WalletRequest struct {
+	From string `json:"from,omitempty"`
+}
+
+// ConfirmTransactionRequest reports a transaction sent from a wallet
+type ConfirmTransactionRequest struct {
+	TxHash string `json:"tx_hash" binding:"required"`
+}
+
+// LicenseResponse represents the response for a single license
+type LicenseResponse struct {
+	Success bool     `json:"success"`
+	Message string   `json:"message,omitempty"`
+	Data    *License `json:"data,omitempty"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// LicenseListResponse represents the response for license listing
+type LicenseListResponse struct {
+	Success bool      `json:"success"`
+	Data    []License `json:"data"`
+	Total   int       `json:"total"`
+	Error   string    `json:"error,omitempty"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+)
+
+// NFT is a minted LicenZNFT token
+type NFT struct {
+	TokenID     string    `json:"token_id"`
+	ContentHash string    `json:"content_hash"`
+	Creator     string    `json:"creator"` // sender of the mint; the server's wallet for custodial mints
+	TokenURI    string    `json:"token_uri"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// MintRequest asks for content to be minted as a LicenZNFT token. The
+// server mints it when it holds a minter key, unless Wallet asks for a
+// transaction to send from the user's wallet instead.
+type MintRequest struct {
+	TokenURI string `json:"token_uri" binding:"required"`
+	To       string `json:"to,omitempty"` // Recipient; defaults to the caller's wallet
+	Wallet   bool   `json:"wallet,omitempty"`
+	From     string `json:"from,omitempty"` // Sender when Wallet is set; defaults to the caller's wallet
+}
+
+// NFTResponse represents the response for a token lookup
+type NFTResponse struct {
+	Success bool   `json:"success"`
+	Minted  bool   `json:"minted"`
+	Data    *NFT   `json:"data,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
@@ -0,0 +1,156 @@
+// Package ratelimit throttles callers. Limiter is a token bucket per caller
+// for bursts of requests; Quotas caps what each caller can consume per UTC
+// day. Both report a Decision that Abort turns into a 429.
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often idle buckets are dropped
+const sweepInterval = time.Minute
+
+// Limit allows Requests per Period, in bursts of up to Requests
+type Limit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// ParseLimit reads a limit such as "30/1m" or "5/s". An empty spec returns
+// def; "off" or "0" disables the limit.
+func ParseLimit(spec string, def Limit) (Limit, error) {
+	spec = strings.TrimSpace(spec)
+	switch spec {
+	case "":
+		return def, nil
+	case "off", "0":
+		return Limit{}, nil
+	}
+
+	count, period, ok := strings.Cut(spec, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid rate limit %q, want requests/period", spec)
+	}
+	requests, err := strconv.Atoi(strings.TrimSpace(count))
+	if err != nil || requests < 0 {
+		return Limit{}, fmt.Errorf("invalid request count in rate limit %q", spec)
+	}
+	period = strings.TrimSpace(period)
+	if period != "" && !strings.ContainsAny(period[:1], "0123456789") {
+		period = "1" + period // "/m" means "/1m"
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return Limit{}, fmt.Errorf("invalid period in rate limit %q", spec)
+	}
+	return Limit{Requests: requests, Period: d}, nil
+}
+
+// Enabled reports whether the limit restricts anything
+func (l Limit) Enabled() bool {
+	return l.Requests > 0 && l.Period > 0
+}
+
+// String formats the limit the way ParseLimit reads it
+func (l Limit) String() string {
+	if !l.Enabled() {
+		return "off"
+	}
+	return fmt.Sprintf("%d/%s", l.Requests, l.Period)
+}
+
+// Decision is the outcome of a rate limit or quota check
+type Decision struct {
+	Allowed    bool
+	Limit      int64
+	Remaining  int64
+	Reset      time.Duration // until the allowance is full again
+	RetryAfter time.Duration // until the next request can succeed; zero if allowed
+	Day        string        // UTC day a quota reservation was charged to
+}
+
+// bucket holds the tokens left for one key
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter is a set of token buckets, one per key, sharing a Limit
+type Limiter struct {
+	mutex     sync.Mutex
+	limit     Limit
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewLimiter creates a limiter enforcing limit for every key
+func NewLimiter(limit Limit) *Limiter {
+	return &Limiter{limit: limit, buckets: make(map[string]*bucket), now: time.Now}
+}
+
+// Limit returns the configured limit
+func (l *Limiter) Limit() Limit {
+	return l.limit
+}
+
+// Allow takes a token from key's bucket if one is left
+func (l *Limiter) Allow(key string) Decision {
+	if !l.limit.Enabled() {
+		return Decision{Allowed: true}
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	capacity := float64(l.limit.Requests)
+	perToken := l.limit.Period / time.Duration(l.limit.Requests)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = l.refill(b, now)
+	b.last = now
+
+	d := Decision{Limit: int64(l.limit.Requests)}
+	if b.tokens >= 1 {
+		b.tokens--
+		d.Allowed = true
+	} else {
+		d.RetryAfter = time.Duration((1 - b.tokens) * float64(perToken))
+	}
+	d.Remaining = int64(math.Floor(b.tokens))
+	d.Reset = time.Duration((capacity - b.tokens) * float64(perToken))
+	return d
+}
+
+// refill returns the tokens in b at now
+func (l *Limiter) refill(b *bucket, now time.Time) float64 {
+	elapsed := now.Sub(b.last)
+	tokens := b.tokens + float64(elapsed)/float64(l.limit.Period)*float64(l.limit.Requests)
+	return math.Min(tokens, float64(l.limit.Requests))
+}
+
+// sweep drops buckets that have refilled completely, since a fresh bucket
+// behaves the same; callers must hold the lock
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if l.refill(b, now) >= float64(l.limit.Requests) {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+// clock is a settable time source
+type clock struct{ t time.Time }
+
+func (c *clock) now() time.Time { return c.t }
+
+func TestParseLimit(t *testing.T) {
+	def := Limit{Requests: 1, Period: time.Hour}
+	cases := map[string]Limit{
+		"":      def,
+		"30/1m": {Requests: 30, Period: time.Minute},
+		"5/s":   {Requests: 5, Period: time.Second},
+		" 2/h ": {Requests: 2, Period: time.Hour},
+		"off":   {},
+		"0":     {},
+	}
+	for spec, want := range cases {
+		got, err := ParseLimit(spec, def)
+		if err != nil || got != want {
+			t.Errorf("ParseLimit(%q) = %v, %v; want %v", spec, got, err, want)
+		}
+	}
+	for _, spec := range []string{"30", "x/m", "3/fortnight", "3/-1s"} {
+		if _, err := ParseLimit(spec, def); err == nil {
+			t.Errorf("ParseLimit(%q) accepted", spec)
+		}
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	clk := &clock{t: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	l := NewLimiter(Limit{Requests: 3, Period: 3 * time.Second})
+	l.now = clk.now
+
+	for i := 0; i < 3; i++ {
+		if d := l.Allow("a"); !d.Allowed || d.Remaining != int64(2-i) {
+			t.Fatalf("request %d = %+v", i, d)
+		}
+	}
+	d := l.Allow("a")
+	if d.Allowed || d.RetryAfter != time.Second || d.Reset != 3*time.Second {
+		t.Fatalf("over limit = %+v", d)
+	}
+
+	// Keys have separate buckets
+	if !l.Allow("b").Allowed {
+		t.Fatal("b limited by a's requests")
+	}
+
+	// One token comes back per second
+	clk.t = clk.t.Add(time.Second)
+	if !l.Allow("a").Allowed {
+		t.Fatal("token not refilled")
+	}
+	if l.Allow("a").Allowed {
+		t.Fatal("refilled more than one token")
+	}
+
+	// Idle buckets are swept once full again
+	clk.t = clk.t.Add(time.Hour)
+	l.Allow("c")
+	if len(l.buckets) != 1 {
+		t.Fatalf("%d buckets after sweep, want 1", len(l.buckets))
+	}
+
+	if d := NewLimiter(Limit{}).Allow("a"); !d.Allowed {
+		t.Fatal("disabled limiter rejected a request")
+	}
+}
+
+func TestQuotas(t *testing.T) {
+	clk := &clock{t: time.Date(2026, 1, 1, 18, 0, 0, 0, time.UTC)}
+	q := NewQuotas(Quota{StorageBytes: 100, Generations: 1})
+	q.now = clk.now
+
+	reserved, err := q.ReserveStorage("a", 60)
+	if err != nil || reserved.Day != "2026-01-01" {
+		t.Fatalf("ReserveStorage = %+v, %v", reserved, err)
+	}
+	d, err := q.ReserveStorage("a", 60)
+	if err != ErrQuotaExceeded || d.Remaining != 40 || d.RetryAfter != 6*time.Hour {
+		t.Fatalf("over quota = %+v, %v", d, err)
+	}
+	q.ReleaseStorage("a", 60, reserved.Day)
+	if _, err := q.ReserveStorage("a", 100); err != nil {
+		t.Fatalf("released bytes not available: %v", err)
+	}
+
+	if _, err := q.ReserveGeneration("a"); err != nil {
+		t.Fatalf("ReserveGeneration: %v", err)
+	}
+	if _, err := q.ReserveGeneration("a"); err != ErrQuotaExceeded {
+		t.Fatalf("second generation = %v", err)
+	}
+	if _, err := q.ReserveGeneration("b"); err != nil {
+		t.Fatalf("b limited by a's usage: %v", err)
+	}
+
+	// Usage resets at UTC midnight
+	yesterday, err := q.ReserveStorage("c", 100)
+	if err != nil {
+		t.Fatalf("ReserveStorage: %v", err)
+	}
+	clk.t = clk.t.Add(6 * time.Hour)
+	if _, err := q.ReserveGeneration("a"); err != nil {
+		t.Fatalf("quota not reset: %v", err)
+	}
+
+	// Refunding yesterday's bytes does not free any of today's quota
+	if _, err := q.ReserveStorage("c", 100); err != nil {
+		t.Fatalf("ReserveStorage: %v", err)
+	}
+	q.ReleaseStorage("c", 100, yesterday.Day)
+	if _, err := q.ReserveStorage("c", 1); err != ErrQuotaExceeded {
+		t.Fatalf("reserve after refunding yesterday = %v, want ErrQuotaExceeded", err)
+	}
+}
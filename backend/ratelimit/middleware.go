@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"licenz-backend/auth"
+	"licenz-backend/models"
+)
+
+// Key identifies the caller for limiting: the API key when one was used,
+// otherwise the authenticated user, otherwise the client IP
+func Key(c *gin.Context) string {
+	if id, ok := auth.FromContext(c.Request.Context()); ok {
+		if id.KeyID != "" {
+			return "key:" + id.KeyID
+		}
+		return "user:" + id.Subject
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// UserKey identifies the caller for quotas, which belong to a user whichever
+// of their credentials is used: the authenticated user, otherwise the
+// client IP
+func UserKey(c *gin.Context) string {
+	if id, ok := auth.FromContext(c.Request.Context()); ok {
+		return SubjectKey(id.Subject)
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// SubjectKey is UserKey for an authenticated subject, for charging quotas
+// outside a request
+func SubjectKey(subject string) string {
+	return "user:" + subject
+}
+
+// Middleware rejects callers that have used up their bucket in l. Run it
+// after auth.Middleware so authenticated callers are limited by identity.
+func Middleware(l *Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		d := l.Allow(Key(c))
+		if !d.Allowed {
+			Abort(c, d, "Rate limit exceeded, retry in "+strconv.FormatInt(seconds(d.RetryAfter), 10)+"s")
+			return
+		}
+		SetHeaders(c, d)
+		c.Next()
+	}
+}
+
+// SetHeaders reports d in the X-RateLimit-* headers
+func SetHeaders(c *gin.Context, d Decision) {
+	if d.Limit == 0 {
+		return
+	}
+	c.Header("X-RateLimit-Limit", strconv.FormatInt(d.Limit, 10))
+	c.Header("X-RateLimit-Remaining", strconv.FormatInt(d.Remaining, 10))
+	c.Header("X-RateLimit-Reset", strconv.FormatInt(seconds(d.Reset), 10))
+}
+
+// Abort ends the request with a 429 describing d
+func Abort(c *gin.Context, d Decision, message string) {
+	SetHeaders(c, d)
+	c.Header("Retry-After", strconv.FormatInt(seconds(d.RetryAfter), 10))
+	c.AbortWithStatusJSON(http.StatusTooManyRequests, models.ContentResponse{
+		Success: false,
+		Error:   message,
+	})
+}
+
+// seconds rounds d up to whole seconds, as HTTP headers want
+func seconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,130 @@
+package ratelimit
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrQuotaExceeded is returned when a caller has used up a daily quota
+var ErrQuotaExceeded = errors.New("daily quota exceeded")
+
+// Quota is what one caller may use per UTC day; zero means unlimited
+type Quota struct {
+	StorageBytes int64
+	Generations  int64
+}
+
+// usage is what a caller has used on day
+type usage struct {
+	day          string
+	storageBytes int64
+	generations  int64
+}
+
+// Quotas tracks daily usage per caller. Usage is kept in memory, so a
+// restart starts everyone's day afresh.
+type Quotas struct {
+	mutex sync.Mutex
+	quota Quota
+	usage map[string]*usage
+	now   func() time.Time
+}
+
+// NewQuotas creates a tracker enforcing quota
+func NewQuotas(quota Quota) *Quotas {
+	return &Quotas{quota: quota, usage: make(map[string]*usage), now: time.Now}
+}
+
+// Quota returns the configured quota
+func (q *Quotas) Quota() Quota {
+	return q.quota
+}
+
+// ReserveStorage records n stored bytes for key unless that would exceed
+// the storage quota
+func (q *Quotas) ReserveStorage(key string, n int64) (Decision, error) {
+	return q.reserve(key, q.quota.StorageBytes, n, func(u *usage) *int64 { return &u.storageBytes })
+}
+
+// ReleaseStorage returns n bytes reserved on day, the Day of the
+// reservation's Decision, for an upload that failed. Bytes reserved on an
+// earlier day are not returned, since that day's usage is gone.
+func (q *Quotas) ReleaseStorage(key string, n int64, day string) {
+	q.release(key, n, day, func(u *usage) *int64 { return &u.storageBytes })
+}
+
+// ReserveGeneration records one generation for key unless that would
+// exceed the generation quota
+func (q *Quotas) ReserveGeneration(key string) (Decision, error) {
+	return q.reserve(key, q.quota.Generations, 1, func(u *usage) *int64 { return &u.generations })
+}
+
+// reserve adds n to the counter picked by field if it stays within limit
+func (q *Quotas) reserve(key string, limit, n int64, field func(*usage) *int64) (Decision, error) {
+	now := q.now().UTC()
+	if limit <= 0 {
+		return Decision{Allowed: true, Day: dayOf(now)}, nil
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	used := field(q.today(key, now))
+	d := Decision{Limit: limit, Reset: nextDay(now).Sub(now), Day: dayOf(now)}
+
+	if *used+n > limit {
+		d.Remaining = limit - *used
+		d.RetryAfter = d.Reset
+		return d, ErrQuotaExceeded
+	}
+	*used += n
+	d.Allowed = true
+	d.Remaining = limit - *used
+	return d, nil
+}
+
+// release subtracts n from the counter picked by field if day is today
+func (q *Quotas) release(key string, n int64, day string, field func(*usage) *int64) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	now := q.now().UTC()
+	if day != dayOf(now) {
+		return
+	}
+	used := field(q.today(key, now))
+	if *used -= n; *used < 0 {
+		*used = 0
+	}
+}
+
+// today returns key's usage for the day of now, dropping every caller's
+// usage from earlier days; callers must hold the lock
+func (q *Quotas) today(key string, now time.Time) *usage {
+	day := dayOf(now)
+	u, ok := q.usage[key]
+	if !ok || u.day != day {
+		if ok {
+			for k, old := range q.usage {
+				if old.day != day {
+					delete(q.usage, k)
+				}
+			}
+		}
+		u = &usage{day: day}
+		q.usage[key] = u
+	}
+	return u
+}
+
+// dayOf names the UTC day of now
+func dayOf(now time.Time) string {
+	return now.Format("2006-01-02")
+}
+
+// nextDay returns the next UTC midnight after now
+func nextDay(now time.Time) time.Time {
+	y, m, d := now.Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, time.UTC)
+}
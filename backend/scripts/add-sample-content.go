@@ -13,7 +13,11 @@ func main() {
 	fmt.Println("🎨 Adding sample content to persistent database...")
 	
 	// Create simple persistent database
-	db := database.NewSimplePersistentDB()
+	db, err := database.NewSimplePersistentDB()
+	if err != nil {
+		log.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
 	
 	// Sample content data
 	sampleContent := []models.Content{
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"licenz-backend/blobstore"
+	"licenz-backend/database"
+	"licenz-backend/derivatives"
+	"licenz-backend/imaging"
+)
+
+// Renders missing thumbnails for every stored image. Safe to re-run: sizes
+// that already exist are skipped.
+// Usage: DB_BACKEND=json go run ./scripts/backfill-thumbnails -blobs data/blobs -out data/thumbnails
+func main() {
+	blobDir := flag.String("blobs", "data/blobs", "blob store directory")
+	outDir := flag.String("out", "data/thumbnails", "thumbnail directory")
+	sizeSpec := flag.String("sizes", "256,1024", "comma-separated thumbnail sizes")
+	workers := flag.Int("workers", 4, "parallel workers")
+	maxPixels := flag.Int64("max-pixels", imaging.DefaultLimits.MaxPixels, "largest image to decode, as IMAGE_MAX_PIXELS")
+	flag.Parse()
+
+	sizes, err := derivatives.ParseSizes(*sizeSpec)
+	if err != nil {
+		log.Fatalf("Invalid sizes: %v", err)
+	}
+
+	store, err := database.Open(database.Config{
+		Backend: os.Getenv("DB_BACKEND"),
+		Path:    os.Getenv("DB_PATH"),
+	})
+	if err != nil {
+		log.Fatalf("Failed to open database: %v", err)
+	}
+
+	blobs, err := blobstore.NewFilesystemStore(*blobDir)
+	if err != nil {
+		log.Fatalf("Failed to open blob store: %v", err)
+	}
+
+	limits := imaging.DefaultLimits
+	limits.MaxPixels = *maxPixels
+	gen, err := derivatives.NewGenerator(blobs, *outDir, sizes, 0, limits)
+	if err != nil {
+		log.Fatalf("Failed to open thumbnail directory: %v", err)
+	}
+
+	all, err := store.GetAllContent(database.ListOptions{})
+	if err != nil {
+		log.Fatalf("Failed to list content: %v", err)
+	}
+
+	// Records sharing an image share thumbnails, so queue each blob once
+	pool := derivatives.NewPool(gen, *workers, len(all.Items))
+	queued, skipped := 0, 0
+	for _, content := range all.Items {
+		if content.ImageBlob == "" || !derivatives.Supported(content.MimeType) {
+			skipped++
+			continue
+		}
+		if pool.Enqueue(content.ImageBlob) {
+			queued++
+		}
+	}
+	pool.Close()
+
+	fmt.Printf("✅ Rendered thumbnails for %d images into %s (%d records skipped)\n", queued, *outDir, skipped)
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/abigen"
+)
+
+// contracts maps each LicenZ contract to the binding file generated for it
+var contracts = []struct {
+	name string
+	file string
+}{
+	{"LicenZContent", "licenz_content.go"},
+	{"LicenZContentSimple", "licenz_content_simple.go"},
+	{"LicenZLicense", "licenz_license.go"},
+	{"LicenZNFT", "licenz_nft.go"},
+}
+
+// artifact is the part of a Hardhat build artifact the bindings need
+type artifact struct {
+	ABI      json.RawMessage `json:"abi"`
+	Bytecode string          `json:"bytecode"`
+}
+
+// Generates the Go bindings in backend/contracts. With -artifacts it reads
+// the Hardhat build output, refreshes the checked-in ABIs and includes the
+// bytecode, so the bindings gain Deploy functions. Without it the bindings
+// are rebuilt from the checked-in ABIs alone.
+// Usage: cd blockchain && npx hardhat compile && cd ../backend/contracts && go generate
+func main() {
+	artifactDir := flag.String("artifacts", "", "Hardhat artifacts/contracts directory (optional)")
+	abiDir := flag.String("abi", "abi", "directory of checked-in contract ABIs")
+	outDir := flag.String("out", ".", "output directory for the bindings")
+	pkg := flag.String("pkg", "contracts", "Go package name of the bindings")
+	flag.Parse()
+
+	for _, contract := range contracts {
+		abiPath := filepath.Join(*abiDir, contract.name+".json")
+
+		var bytecode string
+		if *artifactDir != "" {
+			built, err := readArtifact(*artifactDir, contract.name)
+			if err != nil {
+				log.Fatalf("Failed to read %s artifact: %v", contract.name, err)
+			}
+			if err := writeABI(abiPath, built.ABI); err != nil {
+				log.Fatalf("Failed to write %s ABI: %v", contract.name, err)
+			}
+			bytecode = built.Bytecode
+		}
+
+		abiJSON, err := os.ReadFile(abiPath)
+		if err != nil {
+			log.Fatalf("Failed to read %s ABI: %v", contract.name, err)
+		}
+
+		code, err := abigen.Bind(
+			[]string{contract.name},
+			[]string{string(abiJSON)},
+			[]string{bytecode},
+			nil, *pkg, nil, nil,
+		)
+		if err != nil {
+			log.Fatalf("Failed to generate %s bindings: %v", contract.name, err)
+		}
+
+		out := filepath.Join(*outDir, contract.file)
+		if err := os.WriteFile(out, []byte(code), 0644); err != nil {
+			log.Fatalf("Failed to write %s: %v", out, err)
+		}
+		fmt.Printf("✅ %s -> %s\n", contract.name, out)
+	}
+}
+
+// readArtifact loads dir/<name>.sol/<name>.json
+func readArtifact(dir, name string) (*artifact, error) {
+	data, err := os.ReadFile(filepath.Join(dir, name+".sol", name+".json"))
+	if err != nil {
+		return nil, err
+	}
+	var built artifact
+	if err := json.Unmarshal(data, &built); err != nil {
+		return nil, fmt.Errorf("failed to parse artifact: %v", err)
+	}
+	if len(built.ABI) == 0 {
+		return nil, fmt.Errorf("artifact has no ABI")
+	}
+	return &built, nil
+}
+
+// writeABI stores an ABI indented, the way it is checked in
+func writeABI(path string, raw json.RawMessage) error {
+	var entries []interface{}
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return fmt.Errorf("failed to parse ABI: %v", err)
+	}
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"licenz-backend/database"
+)
+
+// Imports an existing data/content.json into the SQLite content store.
+// Usage: go run ./scripts/import-content -json data/content.json -db data/content.db
+func main() {
+	jsonPath := flag.String("json", "data/content.json", "JSON database written by the json/persistent backends")
+	dbPath := flag.String("db", database.DefaultSQLitePath, "SQLite database to import into")
+	flag.Parse()
+
+	fmt.Printf("📦 Importing %s into %s...\n", *jsonPath, *dbPath)
+
+	db, err := database.NewSQLiteDB(*dbPath)
+	if err != nil {
+		log.Fatalf("Failed to open SQLite database: %v", err)
+	}
+	defer db.Close()
+
+	imported, err := db.ImportJSON(*jsonPath)
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	count, err := db.GetContentCount()
+	if err != nil {
+		log.Fatalf("Failed to get content count: %v", err)
+	}
+
+	fmt.Printf("✅ Imported %d new content items (%d total in database)\n", imported, count)
+	fmt.Println("🚀 Start the backend with DB_BACKEND=sqlite to serve from the new database")
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"licenz-backend/blobstore"
+	"licenz-backend/database"
+	"licenz-backend/imaging"
+)
+
+// Moves base64 ImageData embedded in existing content records into the blob
+// store, leaving only the blob reference on the record.
+// Usage: DB_BACKEND=json go run ./scripts/migrate-images -blobs data/blobs
+func main() {
+	blobDir := flag.String("blobs", "data/blobs", "blob store directory")
+	flag.Parse()
+
+	store, err := database.Open(database.Config{
+		Backend: os.Getenv("DB_BACKEND"),
+		Path:    os.Getenv("DB_PATH"),
+	})
+	if err != nil {
+		log.Fatalf("Failed to open database: %v", err)
+	}
+
+	blobs, err := blobstore.NewFilesystemStore(*blobDir)
+	if err != nil {
+		log.Fatalf("Failed to open blob store: %v", err)
+	}
+
+	// Load everything up front: updating records while paging would shift pages
+	all, err := store.GetAllContent(database.ListOptions{})
+	if err != nil {
+		log.Fatalf("Failed to list content: %v", err)
+	}
+
+	migrated, skipped := 0, 0
+	for _, content := range all.Items {
+		if content.ImageBlob != "" || content.ImageData == "" {
+			continue
+		}
+
+		imageBytes, err := imaging.DecodeData(content.ImageData, 0)
+		if err != nil {
+			log.Printf("⚠️ Skipping %s: ImageData is not valid base64", content.ID)
+			skipped++
+			continue
+		}
+
+		blob, err := blobs.Put(context.Background(), bytes.NewReader(imageBytes))
+		if err != nil {
+			log.Fatalf("Failed to store image for %s: %v", content.ID, err)
+		}
+
+		content.ImageBlob = blob.Key
+		content.ImageSize = blob.Size
+		content.MimeType = imaging.MimeType(imageBytes)
+		content.ImageURL = "/api/content/" + content.ID + "/download"
+		content.ImageData = ""
+
+		if err := store.UpdateContent(content); err != nil {
+			log.Fatalf("Failed to update %s: %v", content.ID, err)
+		}
+		migrated++
+	}
+
+	fmt.Printf("✅ Moved %d images into %s (%d skipped)\n", migrated, *blobDir, skipped)
+}
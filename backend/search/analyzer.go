@@ -0,0 +1,64 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// token is one analyzed word: its index term, its position in the token
+// stream (stop words keep their slot so phrases stay aligned) and its byte
+// range in the source text
+type token struct {
+	term       string
+	pos        int
+	start, end int
+}
+
+// stopWords are dropped from both documents and queries
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "but": true, "by": true, "for": true, "from": true, "if": true,
+	"in": true, "into": true, "is": true, "it": true, "its": true, "no": true,
+	"not": true, "of": true, "on": true, "or": true, "over": true, "such": true,
+	"that": true, "the": true, "their": true, "then": true, "there": true,
+	"these": true, "they": true, "this": true, "to": true, "was": true,
+	"will": true, "with": true,
+}
+
+// analyze splits text into lowercased, stemmed terms with stop words removed.
+// Positions start at base.
+func analyze(text string, base int) []token {
+	var tokens []token
+	pos := base
+
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if !isWordRune(r) {
+			i += size
+			continue
+		}
+
+		start := i
+		for i < len(text) {
+			r, size := utf8.DecodeRuneInString(text[i:])
+			if !isWordRune(r) {
+				break
+			}
+			i += size
+		}
+
+		word := strings.ToLower(text[start:i])
+		if !stopWords[word] {
+			tokens = append(tokens, token{term: stem(word), pos: pos, start: start, end: i})
+		}
+		pos++
+	}
+
+	return tokens
+}
+
+// isWordRune reports whether r belongs inside a word
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,346 @@
+// Package search is an in-memory inverted index over content prompts,
+// styles and models. It supports stemmed, case-insensitive terms, quoted
+// phrases and trailing-* prefix queries, ranks matches with BM25 and
+// produces highlighted snippets.
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"licenz-backend/models"
+)
+
+// BM25 parameters
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// fieldGap separates fields in the position space so phrases never match
+// across the end of the prompt and the start of the style
+const fieldGap = 100
+
+// Hit is one ranked search result
+type Hit struct {
+	ID      string
+	Score   float64
+	Snippet string // prompt excerpt with matches wrapped in <mark></mark>
+}
+
+// SearchOptions pages and scopes a search
+type SearchOptions struct {
+	Offset        int
+	Limit         int  // 0 returns every hit
+	IncludeHidden bool // include content hidden by a moderator
+}
+
+// Results is a page of hits plus the full ranked match set
+type Results struct {
+	Hits  []Hit
+	Total int
+	IDs   []string // every matching ID in rank order, for aggregations
+}
+
+// document is the per-content bookkeeping the index needs for scoring,
+// snippets and facet counts
+type document struct {
+	length int
+	terms  []string // distinct terms, for removal
+	prompt string
+	facets models.Content // only the fields counted by models.Facets, plus Hidden
+}
+
+// Index is a concurrency-safe inverted index, updated incrementally as
+// content is created, updated and deleted
+type Index struct {
+	mutex       sync.RWMutex
+	docs        map[string]*document
+	postings    map[string]map[string][]int // term -> content ID -> positions
+	vocab       []string                    // sorted terms, for prefix lookups
+	totalLength int
+}
+
+// NewIndex creates an empty index
+func NewIndex() *Index {
+	return &Index{
+		docs:     make(map[string]*document),
+		postings: make(map[string]map[string][]int),
+	}
+}
+
+// Add indexes content, replacing any previous version with the same ID
+func (ix *Index) Add(content models.Content) {
+	tokens := analyze(content.Prompt, 0)
+	next := fieldGap
+	if len(tokens) > 0 {
+		next += tokens[len(tokens)-1].pos
+	}
+	styleTokens := analyze(content.Style, next)
+	tokens = append(tokens, styleTokens...)
+	if len(styleTokens) > 0 {
+		next = styleTokens[len(styleTokens)-1].pos + fieldGap
+	}
+	tokens = append(tokens, analyze(content.Model, next)...)
+
+	ix.mutex.Lock()
+	defer ix.mutex.Unlock()
+
+	ix.remove(content.ID)
+
+	doc := &document{
+		length: len(tokens),
+		prompt: content.Prompt,
+		facets: models.Content{
+			Style:       content.Style,
+			Model:       content.Model,
+			IsLicensed:  content.IsLicensed,
+			NFTMinted:   content.NFTMinted,
+			LicenseType: content.LicenseType,
+			UserID:      content.UserID,
+			Hidden:      content.Hidden,
+		},
+	}
+	for _, t := range tokens {
+		docs, ok := ix.postings[t.term]
+		if !ok {
+			docs = make(map[string][]int)
+			ix.postings[t.term] = docs
+			ix.insertVocab(t.term)
+		}
+		if _, seen := docs[content.ID]; !seen {
+			doc.terms = append(doc.terms, t.term)
+		}
+		docs[content.ID] = append(docs[content.ID], t.pos)
+	}
+
+	ix.docs[content.ID] = doc
+	ix.totalLength += doc.length
+}
+
+// Remove drops content from the index
+func (ix *Index) Remove(id string) {
+	ix.mutex.Lock()
+	defer ix.mutex.Unlock()
+
+	ix.remove(id)
+}
+
+// Len returns the number of indexed documents
+func (ix *Index) Len() int {
+	ix.mutex.RLock()
+	defer ix.mutex.RUnlock()
+
+	return len(ix.docs)
+}
+
+// Facets counts the given documents, typically Results.IDs, per facet value
+func (ix *Index) Facets(ids []string) *models.Facets {
+	ix.mutex.RLock()
+	defer ix.mutex.RUnlock()
+
+	facets := models.NewFacets()
+	for _, id := range ids {
+		if doc, ok := ix.docs[id]; ok {
+			facets.Add(&doc.facets)
+		}
+	}
+	return facets
+}
+
+// remove drops a document; callers must hold the write lock
+func (ix *Index) remove(id string) {
+	doc, ok := ix.docs[id]
+	if !ok {
+		return
+	}
+
+	for _, term := range doc.terms {
+		docs := ix.postings[term]
+		delete(docs, id)
+		if len(docs) == 0 {
+			delete(ix.postings, term)
+			ix.removeVocab(term)
+		}
+	}
+
+	ix.totalLength -= doc.length
+	delete(ix.docs, id)
+}
+
+// insertVocab adds a new term to the sorted vocabulary
+func (ix *Index) insertVocab(term string) {
+	i := sort.SearchStrings(ix.vocab, term)
+	ix.vocab = append(ix.vocab, "")
+	copy(ix.vocab[i+1:], ix.vocab[i:])
+	ix.vocab[i] = term
+}
+
+// removeVocab drops a term that no longer has postings
+func (ix *Index) removeVocab(term string) {
+	i := sort.SearchStrings(ix.vocab, term)
+	if i < len(ix.vocab) && ix.vocab[i] == term {
+		ix.vocab = append(ix.vocab[:i], ix.vocab[i+1:]...)
+	}
+}
+
+// expandPrefix returns every indexed term starting with prefix
+func (ix *Index) expandPrefix(prefix string) []string {
+	var terms []string
+	for i := sort.SearchStrings(ix.vocab, prefix); i < len(ix.vocab) && strings.HasPrefix(ix.vocab[i], prefix); i++ {
+		terms = append(terms, ix.vocab[i])
+	}
+	return terms
+}
+
+// Search runs query and returns the requested page of ranked hits along
+// with the total match count. Every clause of the query must match.
+func (ix *Index) Search(query string, opts SearchOptions) Results {
+	clauses := parseQuery(query)
+	if len(clauses) == 0 {
+		return Results{}
+	}
+
+	ix.mutex.RLock()
+	defer ix.mutex.RUnlock()
+
+	highlight := make(map[string]bool)
+	var scores map[string]float64
+	for _, cl := range clauses {
+		clauseScores := ix.scoreClause(cl, highlight)
+		if scores == nil {
+			scores = clauseScores
+			continue
+		}
+		// Intersect: keep only documents matching every clause so far
+		for id, score := range scores {
+			if s, ok := clauseScores[id]; ok {
+				scores[id] = score + s
+			} else {
+				delete(scores, id)
+			}
+		}
+	}
+
+	ranked := make([]Hit, 0, len(scores))
+	for id, score := range scores {
+		if !opts.IncludeHidden && ix.docs[id].facets.Hidden {
+			continue
+		}
+		ranked = append(ranked, Hit{ID: id, Score: score})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].ID < ranked[j].ID
+	})
+
+	results := Results{Total: len(ranked), IDs: make([]string, len(ranked))}
+	for i, hit := range ranked {
+		results.IDs[i] = hit.ID
+	}
+
+	offset := opts.Offset
+	if offset > len(ranked) {
+		offset = len(ranked)
+	}
+	end := len(ranked)
+	if opts.Limit > 0 && offset+opts.Limit < end {
+		end = offset + opts.Limit
+	}
+	results.Hits = ranked[offset:end]
+	for i := range results.Hits {
+		results.Hits[i].Snippet = snippet(ix.docs[results.Hits[i].ID].prompt, highlight)
+	}
+
+	return results
+}
+
+// scoreClause returns the BM25 contribution of one clause for every
+// document it matches, recording the terms it matched for highlighting
+func (ix *Index) scoreClause(cl clause, highlight map[string]bool) map[string]float64 {
+	scores := make(map[string]float64)
+
+	switch {
+	case cl.prefix != "":
+		for _, term := range ix.expandPrefix(cl.prefix) {
+			highlight[term] = true
+			ix.addTermScores(term, scores)
+		}
+
+	case len(cl.terms) == 1:
+		highlight[cl.terms[0]] = true
+		ix.addTermScores(cl.terms[0], scores)
+
+	default:
+		// Phrase: count occurrences where every term sits at its offset
+		first := ix.postings[cl.terms[0]]
+		freqs := make(map[string]int)
+		for id, positions := range first {
+			for _, p := range positions {
+				if ix.phraseAt(id, cl, p) {
+					freqs[id]++
+				}
+			}
+		}
+		idf := ix.idf(len(freqs))
+		for id, tf := range freqs {
+			scores[id] = ix.bm25(idf, tf, ix.docs[id].length)
+		}
+		if len(freqs) > 0 {
+			for _, term := range cl.terms {
+				highlight[term] = true
+			}
+		}
+	}
+
+	return scores
+}
+
+// addTermScores adds the BM25 score of term for each document containing it
+func (ix *Index) addTermScores(term string, scores map[string]float64) {
+	docs := ix.postings[term]
+	idf := ix.idf(len(docs))
+	for id, positions := range docs {
+		scores[id] += ix.bm25(idf, len(positions), ix.docs[id].length)
+	}
+}
+
+// phraseAt reports whether the phrase starts at position p in document id
+func (ix *Index) phraseAt(id string, cl clause, p int) bool {
+	for i := 1; i < len(cl.terms); i++ {
+		if !containsInt(ix.postings[cl.terms[i]][id], p+cl.offsets[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// idf is the BM25 inverse document frequency for a term found in df documents
+func (ix *Index) idf(df int) float64 {
+	n := float64(len(ix.docs))
+	return math.Log(1 + (n-float64(df)+0.5)/(float64(df)+0.5))
+}
+
+// bm25 scores a term occurring tf times in a document of length dl
+func (ix *Index) bm25(idf float64, tf, dl int) float64 {
+	avgdl := float64(ix.totalLength) / float64(len(ix.docs))
+	if avgdl == 0 {
+		avgdl = 1
+	}
+	f := float64(tf)
+	return idf * f * (bm25K1 + 1) / (f + bm25K1*(1-bm25B+bm25B*float64(dl)/avgdl))
+}
+
+// containsInt reports whether list contains v
+func containsInt(list []int, v int) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	"licenz-backend/models"
+)
+
+func testIndex() *Index {
+	index := NewIndex()
+	index.Add(models.Content{ID: "1", Prompt: "A beautiful sunset over the mountains", Style: "photorealistic", Model: "stable-diffusion"})
+	index.Add(models.Content{ID: "2", Prompt: "Mountains at sunrise, running river", Style: "watercolor", Model: "sdxl"})
+	index.Add(models.Content{ID: "3", Prompt: "City skyline at night with neon lights", Style: "cyberpunk", Model: "sdxl"})
+	return index
+}
+
+func ids(results Results) string {
+	var out []string
+	for _, hit := range results.Hits {
+		out = append(out, hit.ID)
+	}
+	return strings.Join(out, ",")
+}
+
+func TestStem(t *testing.T) {
+	cases := map[string]string{
+		"sunsets":     "sunset",
+		"running":     "run",
+		"mountains":   "mountain",
+		"generated":   "gener",
+		"generation":  "gener",
+		"relational":  "relat",
+		"hopefulness": "hope",
+		"caresses":    "caress",
+		"ponies":      "poni",
+		"sky":         "sky",
+	}
+	for word, want := range cases {
+		if got := stem(word); got != want {
+			t.Errorf("stem(%q) = %q, want %q", word, got, want)
+		}
+	}
+}
+
+func TestSearchIsCaseInsensitiveAndStemmed(t *testing.T) {
+	index := testIndex()
+
+	if got := ids(index.Search("Sunset", SearchOptions{Limit: 10})); got != "1" {
+		t.Fatalf("Sunset matched %q, want 1", got)
+	}
+	if got := ids(index.Search("MOUNTAIN", SearchOptions{Limit: 10})); got != "1,2" && got != "2,1" {
+		t.Fatalf("MOUNTAIN matched %q, want 1 and 2", got)
+	}
+	if got := ids(index.Search("runs", SearchOptions{Limit: 10})); got != "2" {
+		t.Fatalf("runs matched %q, want 2", got)
+	}
+	if got := ids(index.Search("the", SearchOptions{Limit: 10})); got != "" {
+		t.Fatalf("stop word matched %q", got)
+	}
+}
+
+func TestSearchRequiresEveryClause(t *testing.T) {
+	index := testIndex()
+
+	if got := ids(index.Search("mountains sunrise", SearchOptions{Limit: 10})); got != "2" {
+		t.Fatalf("mountains sunrise matched %q, want 2", got)
+	}
+	if got := ids(index.Search("sdxl neon", SearchOptions{Limit: 10})); got != "3" {
+		t.Fatalf("sdxl neon matched %q, want 3", got)
+	}
+}
+
+func TestSearchPhraseAndPrefix(t *testing.T) {
+	index := testIndex()
+
+	if got := ids(index.Search(`"beautiful sunset"`, SearchOptions{Limit: 10})); got != "1" {
+		t.Fatalf("phrase matched %q, want 1", got)
+	}
+	if got := ids(index.Search(`"sunset beautiful"`, SearchOptions{Limit: 10})); got != "" {
+		t.Fatalf("reversed phrase matched %q", got)
+	}
+	// Stop words keep their slot, so "sunset over the mountains" lines up
+	if got := ids(index.Search(`"sunset over the mountains"`, SearchOptions{Limit: 10})); got != "1" {
+		t.Fatalf("phrase with stop words matched %q, want 1", got)
+	}
+	// Phrases never span fields
+	if got := ids(index.Search(`"mountains photorealistic"`, SearchOptions{Limit: 10})); got != "" {
+		t.Fatalf("cross-field phrase matched %q", got)
+	}
+	if got := ids(index.Search("sky*", SearchOptions{Limit: 10})); got != "3" {
+		t.Fatalf("sky* matched %q, want 3", got)
+	}
+	if got := ids(index.Search("sun*", SearchOptions{Limit: 10})); got != "1,2" && got != "2,1" {
+		t.Fatalf("sun* matched %q, want 1 and 2", got)
+	}
+}
+
+func TestSearchRanksAndPages(t *testing.T) {
+	index := NewIndex()
+	index.Add(models.Content{ID: "a", Prompt: "dog"})
+	index.Add(models.Content{ID: "b", Prompt: "dog dog dog"})
+	index.Add(models.Content{ID: "c", Prompt: "cat with a long description of many other things"})
+	index.Add(models.Content{ID: "d", Prompt: "dog in a long description of many other things"})
+
+	results := index.Search("dog", SearchOptions{Limit: 2})
+	if results.Total != 3 {
+		t.Fatalf("total = %d, want 3", results.Total)
+	}
+	if got := ids(results); got != "b,a" {
+		t.Fatalf("first page = %q, want b,a", got)
+	}
+	if got := ids(index.Search("dog", SearchOptions{Offset: 2, Limit: 2})); got != "d" {
+		t.Fatalf("second page = %q, want d", got)
+	}
+	if results.Hits[0].Score <= results.Hits[1].Score {
+		t.Fatalf("scores not descending: %v", results.Hits)
+	}
+}
+
+func TestIndexUpdatesIncrementally(t *testing.T) {
+	index := testIndex()
+
+	index.Add(models.Content{ID: "1", Prompt: "A quiet forest"})
+	if got := ids(index.Search("sunset", SearchOptions{Limit: 10})); got != "" {
+		t.Fatalf("stale term still matched %q", got)
+	}
+	if got := ids(index.Search("forest", SearchOptions{Limit: 10})); got != "1" {
+		t.Fatalf("forest matched %q, want 1", got)
+	}
+
+	index.Remove("3")
+	if got := ids(index.Search("neon", SearchOptions{Limit: 10})); got != "" {
+		t.Fatalf("removed content matched %q", got)
+	}
+	if got := index.expandPrefix("neon"); len(got) != 0 {
+		t.Fatalf("vocabulary kept %v after removal", got)
+	}
+}
+
+func TestSnippetHighlightsAndEscapes(t *testing.T) {
+	index := NewIndex()
+	index.Add(models.Content{ID: "1", Prompt: "Sunsets <b>over</b> the sea & sunset skies"})
+
+	got := index.Search("sunset", SearchOptions{Limit: 1}).Hits[0].Snippet
+	want := "<mark>Sunsets</mark> &lt;b&gt;over&lt;/b&gt; the sea &amp; <mark>sunset</mark> skies"
+	if got != want {
+		t.Fatalf("snippet = %q\nwant      %q", got, want)
+	}
+
+	long := strings.Repeat("filler words here ", 20) + "the golden sunset " + strings.Repeat("more filler ", 20)
+	index.Add(models.Content{ID: "2", Prompt: long})
+	got = index.Search("golden", SearchOptions{Limit: 1}).Hits[0].Snippet
+	if !strings.HasPrefix(got, "…") || !strings.HasSuffix(got, "…") || !strings.Contains(got, "<mark>golden</mark>") {
+		t.Fatalf("long snippet = %q", got)
+	}
+}
+
+func TestFacetsCountEveryMatch(t *testing.T) {
+	index := testIndex()
+	index.Add(models.Content{ID: "4", Prompt: "Sunset skyline", Style: "cyberpunk", Model: "sdxl", IsLicensed: true, UserID: "alice"})
+
+	results := index.Search("sunset", SearchOptions{Limit: 1})
+	facets := index.Facets(results.IDs)
+
+	if facets.Style["photorealistic"] != 1 || facets.Style["cyberpunk"] != 1 {
+		t.Fatalf("style facets = %v", facets.Style)
+	}
+	if facets.IsLicensed["true"] != 1 || facets.IsLicensed["false"] != 1 {
+		t.Fatalf("is_licensed facets = %v", facets.IsLicensed)
+	}
+	if facets.Creator["alice"] != 1 || len(facets.Creator) != 1 {
+		t.Fatalf("creator facets = %v", facets.Creator)
+	}
+}
+
+func TestSearchSkipsHiddenContent(t *testing.T) {
+	index := testIndex()
+	index.Add(models.Content{ID: "4", Prompt: "Sunset behind bars", Hidden: true})
+
+	if got := ids(index.Search("sunset", SearchOptions{Limit: 10})); got != "1" {
+		t.Fatalf("sunset matched %q, want 1", got)
+	}
+	if got := index.Search("sunset", SearchOptions{Limit: 10, IncludeHidden: true}); got.Total != 2 {
+		t.Fatalf("total with hidden = %d, want 2", got.Total)
+	}
+}
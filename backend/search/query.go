@@ -0,0 +1,83 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// clause is one required part of a query: a single term, a phrase of
+// terms at fixed relative positions, or a prefix
+type clause struct {
+	terms   []string
+	offsets []int  // position of each term relative to the first
+	prefix  string // lowercase prefix for word* clauses
+}
+
+// parseQuery splits a query into clauses. "Quoted text" is a phrase, a
+// word ending in * is a prefix, and anything else is analyzed into terms
+// exactly like indexed text. Hyphenated or punctuated words such as
+// digital-art become phrases.
+func parseQuery(query string) []clause {
+	var clauses []clause
+
+	for i := 0; i < len(query); {
+		switch {
+		case query[i] == '"':
+			end := strings.IndexByte(query[i+1:], '"')
+			if end < 0 {
+				end = len(query) - i - 1
+			}
+			clauses = append(clauses, termClauses(query[i+1:i+1+end])...)
+			i += end + 2
+
+		case unicode.IsSpace(rune(query[i])):
+			i++
+
+		default:
+			end := strings.IndexFunc(query[i:], func(r rune) bool { return unicode.IsSpace(r) || r == '"' })
+			if end < 0 {
+				end = len(query) - i
+			}
+			word := query[i : i+end]
+			i += end
+
+			if strings.HasSuffix(word, "*") {
+				clauses = append(clauses, prefixClauses(strings.TrimRight(word, "*"))...)
+			} else {
+				clauses = append(clauses, termClauses(word)...)
+			}
+		}
+	}
+
+	return clauses
+}
+
+// termClauses analyzes text into a single-term clause or a phrase clause
+func termClauses(text string) []clause {
+	tokens := analyze(text, 0)
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	cl := clause{}
+	for _, t := range tokens {
+		cl.terms = append(cl.terms, t.term)
+		cl.offsets = append(cl.offsets, t.pos-tokens[0].pos)
+	}
+	return []clause{cl}
+}
+
+// prefixClauses turns word* into a prefix clause on its last word; any
+// earlier words (as in digital-ar*) become ordinary term clauses
+func prefixClauses(word string) []clause {
+	fields := strings.FieldsFunc(strings.ToLower(word), func(r rune) bool { return !isWordRune(r) })
+	if len(fields) == 0 {
+		return nil
+	}
+
+	var clauses []clause
+	for _, f := range fields[:len(fields)-1] {
+		clauses = append(clauses, termClauses(f)...)
+	}
+	return append(clauses, clause{prefix: fields[len(fields)-1]})
+}
@@ -0,0 +1,72 @@
+package search
+
+import (
+	"html"
+	"strings"
+)
+
+// Snippet window, in bytes of source text
+const (
+	snippetLength  = 160
+	snippetContext = 40
+)
+
+// snippet returns an HTML-escaped excerpt of text around the first matched
+// term, with every matched word wrapped in <mark></mark>
+func snippet(text string, highlight map[string]bool) string {
+	tokens := analyze(text, 0)
+
+	var marks []token
+	for _, t := range tokens {
+		if highlight[t.term] {
+			marks = append(marks, t)
+		}
+	}
+
+	start := 0
+	if len(marks) > 0 && marks[0].start > snippetContext {
+		start = wordStart(text, marks[0].start-snippetContext)
+	}
+	end := len(text)
+	if end-start > snippetLength {
+		end = wordEnd(text, start+snippetLength)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, m := range marks {
+		if m.start < start || m.end > end {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[pos:m.start]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(text[m.start:m.end]))
+		b.WriteString("</mark>")
+		pos = m.end
+	}
+	b.WriteString(html.EscapeString(text[pos:end]))
+	if end < len(text) {
+		b.WriteString("…")
+	}
+
+	return b.String()
+}
+
+// wordStart moves i forward to the start of the next word
+func wordStart(text string, i int) int {
+	if j := strings.IndexByte(text[i:], ' '); j >= 0 {
+		return i + j + 1
+	}
+	return i
+}
+
+// wordEnd moves i back to the end of the previous word
+func wordEnd(text string, i int) int {
+	if j := strings.LastIndexByte(text[:i], ' '); j > 0 {
+		return j
+	}
+	return i
+}
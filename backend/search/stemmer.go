@@ -0,0 +1,280 @@
+package search
+
+// stem reduces an English word to its Porter stem, so "sunsets", "running"
+// and "generated" match "sunset", "run" and "generate". Words containing
+// non-ASCII letters are returned unchanged.
+//
+// This is M. F. Porter's 1980 algorithm, following the reference C
+// implementation step by step.
+func stem(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return word
+		}
+	}
+
+	p := &porter{b: []byte(word), k: len(word) - 1}
+	p.step1ab()
+	if p.k > 0 {
+		p.step1c()
+		p.step2()
+		p.step3()
+		p.step4()
+		p.step5()
+	}
+	return string(p.b[:p.k+1])
+}
+
+// porter holds the word being stemmed: b[0..k] is the current stem and j
+// marks the end of the stem preceding a matched suffix
+type porter struct {
+	b    []byte
+	k, j int
+}
+
+// cons reports whether b[i] is a consonant
+func (p *porter) cons(i int) bool {
+	switch p.b[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !p.cons(i-1)
+	}
+	return true
+}
+
+// m counts consonant-vowel sequences in b[0..j]
+func (p *porter) m() int {
+	n, i := 0, 0
+	for {
+		if i > p.j {
+			return n
+		}
+		if !p.cons(i) {
+			break
+		}
+		i++
+	}
+	i++
+	for {
+		for {
+			if i > p.j {
+				return n
+			}
+			if p.cons(i) {
+				break
+			}
+			i++
+		}
+		i++
+		n++
+		for {
+			if i > p.j {
+				return n
+			}
+			if !p.cons(i) {
+				break
+			}
+			i++
+		}
+		i++
+	}
+}
+
+// vowelInStem reports whether b[0..j] contains a vowel
+func (p *porter) vowelInStem() bool {
+	for i := 0; i <= p.j; i++ {
+		if !p.cons(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// doubleC reports whether b[i-1..i] is a double consonant
+func (p *porter) doubleC(i int) bool {
+	return i >= 1 && p.b[i] == p.b[i-1] && p.cons(i)
+}
+
+// cvc reports whether b[i-2..i] is consonant-vowel-consonant and the last
+// consonant is not w, x or y
+func (p *porter) cvc(i int) bool {
+	if i < 2 || !p.cons(i) || p.cons(i-1) || !p.cons(i-2) {
+		return false
+	}
+	switch p.b[i] {
+	case 'w', 'x', 'y':
+		return false
+	}
+	return true
+}
+
+// ends reports whether b[0..k] ends with s, setting j accordingly
+func (p *porter) ends(s string) bool {
+	n := len(s)
+	if n > p.k+1 || string(p.b[p.k-n+1:p.k+1]) != s {
+		return false
+	}
+	p.j = p.k - n
+	return true
+}
+
+// setTo replaces b[j+1..k] with s
+func (p *porter) setTo(s string) {
+	p.b = append(p.b[:p.j+1], s...)
+	p.k = p.j + len(s)
+}
+
+// r replaces the suffix with s when the remaining stem has m() > 0
+func (p *porter) r(s string) {
+	if p.m() > 0 {
+		p.setTo(s)
+	}
+}
+
+// step1ab removes plurals and -ed or -ing
+func (p *porter) step1ab() {
+	if p.b[p.k] == 's' {
+		switch {
+		case p.ends("sses"):
+			p.k -= 2
+		case p.ends("ies"):
+			p.setTo("i")
+		case p.b[p.k-1] != 's':
+			p.k--
+		}
+	}
+
+	if p.ends("eed") {
+		if p.m() > 0 {
+			p.k--
+		}
+	} else if (p.ends("ed") || p.ends("ing")) && p.vowelInStem() {
+		p.k = p.j
+		switch {
+		case p.ends("at"):
+			p.setTo("ate")
+		case p.ends("bl"):
+			p.setTo("ble")
+		case p.ends("iz"):
+			p.setTo("ize")
+		case p.doubleC(p.k):
+			p.k--
+			switch p.b[p.k] {
+			case 'l', 's', 'z':
+				p.k++
+			}
+		default:
+			p.j = p.k
+			if p.m() == 1 && p.cvc(p.k) {
+				p.setTo("e")
+			}
+		}
+	}
+}
+
+// step1c turns terminal y into i when there is another vowel in the stem
+func (p *porter) step1c() {
+	if p.ends("y") && p.vowelInStem() {
+		p.b[p.k] = 'i'
+	}
+}
+
+// step2 maps double suffixes to single ones
+func (p *porter) step2() {
+	if p.k < 1 {
+		return
+	}
+	for _, rule := range step2Rules[p.b[p.k-1]] {
+		if p.ends(rule[0]) {
+			p.r(rule[1])
+			return
+		}
+	}
+}
+
+var step2Rules = map[byte][][2]string{
+	'a': {{"ational", "ate"}, {"tional", "tion"}},
+	'c': {{"enci", "ence"}, {"anci", "ance"}},
+	'e': {{"izer", "ize"}},
+	'l': {{"bli", "ble"}, {"alli", "al"}, {"entli", "ent"}, {"eli", "e"}, {"ousli", "ous"}},
+	'o': {{"ization", "ize"}, {"ation", "ate"}, {"ator", "ate"}},
+	's': {{"alism", "al"}, {"iveness", "ive"}, {"fulness", "ful"}, {"ousness", "ous"}},
+	't': {{"aliti", "al"}, {"iviti", "ive"}, {"biliti", "ble"}},
+	'g': {{"logi", "log"}},
+}
+
+// step3 handles -ic-, -full, -ness and similar
+func (p *porter) step3() {
+	for _, rule := range step3Rules[p.b[p.k]] {
+		if p.ends(rule[0]) {
+			p.r(rule[1])
+			return
+		}
+	}
+}
+
+var step3Rules = map[byte][][2]string{
+	'e': {{"icate", "ic"}, {"ative", ""}, {"alize", "al"}},
+	'i': {{"iciti", "ic"}},
+	'l': {{"ical", "ic"}, {"ful", ""}},
+	's': {{"ness", ""}},
+}
+
+// step4 removes -ant, -ence and similar when m() > 1
+func (p *porter) step4() {
+	if p.k < 1 {
+		return
+	}
+	matched := false
+	for _, suffix := range step4Suffixes[p.b[p.k-1]] {
+		if p.ends(suffix) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return
+	}
+	if p.b[p.k-1] == 'o' && p.ends("ion") && (p.j < 0 || (p.b[p.j] != 's' && p.b[p.j] != 't')) {
+		// -ion is only removed after s or t; fall back to -ou
+		if !p.ends("ou") {
+			return
+		}
+	}
+	if p.m() > 1 {
+		p.k = p.j
+	}
+}
+
+var step4Suffixes = map[byte][]string{
+	'a': {"al"},
+	'c': {"ance", "ence"},
+	'e': {"er"},
+	'i': {"ic"},
+	'l': {"able", "ible"},
+	'n': {"ant", "ement", "ment", "ent"},
+	'o': {"ion", "ou"},
+	's': {"ism"},
+	't': {"ate", "iti"},
+	'u': {"ous"},
+	'v': {"ive"},
+	'z': {"ize"},
+}
+
+// step5 removes a final -e and reduces -ll when m() > 1
+func (p *porter) step5() {
+	p.j = p.k
+	if p.b[p.k] == 'e' {
+		a := p.m()
+		if a > 1 || (a == 1 && !p.cvc(p.k-1)) {
+			p.k--
+		}
+	}
+	if p.b[p.k] == 'l' && p.doubleC(p.k) && p.m() > 1 {
+		p.k--
+	}
+}
@@ -0,0 +1,87 @@
+package search
+
+import (
+	"fmt"
+
+	"licenz-backend/database"
+	"licenz-backend/models"
+)
+
+// IndexedStore wraps a ContentStore and keeps an Index in step with every
+// successful create, update and delete
+type IndexedStore struct {
+	database.ContentStore
+	index *Index
+}
+
+var _ database.ContentStore = (*IndexedStore)(nil)
+
+// NewIndexedStore builds an index over everything already in store
+func NewIndexedStore(store database.ContentStore) (*IndexedStore, error) {
+	existing, err := store.GetAllContent(database.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load content for search index: %v", err)
+	}
+
+	index := NewIndex()
+	for _, content := range existing.Items {
+		index.Add(content)
+	}
+
+	fmt.Printf("🔎 Indexed %d content items for search\n", index.Len())
+	return &IndexedStore{ContentStore: store, index: index}, nil
+}
+
+// Index returns the search index
+func (s *IndexedStore) Index() *Index {
+	return s.index
+}
+
+// Unwrap returns the underlying store
+func (s *IndexedStore) Unwrap() database.ContentStore {
+	return s.ContentStore
+}
+
+// CreateContent stores content and indexes it
+func (s *IndexedStore) CreateContent(content models.Content) error {
+	if err := s.ContentStore.CreateContent(content); err != nil {
+		return err
+	}
+	s.index.Add(content)
+	return nil
+}
+
+// UpdateContent stores content and reindexes it
+func (s *IndexedStore) UpdateContent(content models.Content) error {
+	if err := s.ContentStore.UpdateContent(content); err != nil {
+		return err
+	}
+	s.index.Add(content)
+	return nil
+}
+
+// DeleteContent deletes content and drops it from the index
+func (s *IndexedStore) DeleteContent(id string) error {
+	if err := s.ContentStore.DeleteContent(id); err != nil {
+		return err
+	}
+	s.index.Remove(id)
+	return nil
+}
+
+// SearchContent returns the best-ranked visible matches for query from the index
+func (s *IndexedStore) SearchContent(query string, limit int) ([]models.Content, error) {
+	results := s.index.Search(query, SearchOptions{Limit: limit})
+
+	matches := make([]models.Content, 0, len(results.Hits))
+	for _, hit := range results.Hits {
+		content, err := s.ContentStore.GetContent(hit.ID)
+		if err != nil {
+			return nil, err
+		}
+		if content != nil {
+			matches = append(matches, *content)
+		}
+	}
+	return matches, nil
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"log"
 	"math/big"
@@ -10,40 +11,73 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"licenz-backend/contracts"
 )
 
-// ContentStorageService handles blockchain interactions
+// ContentStorageService handles blockchain interactions with a deployed
+// LicenZContent contract, through its generated binding
 type ContentStorageService struct {
-	client        *ethclient.Client
-	contractAddr  common.Address
-	contractABI   abi.ABI
-	privateKey    *ecdsa.PrivateKey
-	fromAddress   common.Address
+	client       chainBackend
+	contract     *contracts.LicenZContent
+	contractAddr common.Address
+	privateKey   *ecdsa.PrivateKey
+	fromAddress  common.Address
 }
 
-// Content represents AI-generated content stored on blockchain
+// chainBackend is the part of an Ethereum client the services use. Both
+// *ethclient.Client and the simulated backend used in tests satisfy it.
+type chainBackend interface {
+	bind.ContractBackend
+	ethereum.TransactionReader
+	ethereum.ChainIDReader
+}
+
+// Content is a LicenZContent record as the API returns it
 type Content struct {
-	ID           *big.Int `json:"id"`
-	IpfsHash     string   `json:"ipfsHash"`
-	Prompt       string   `json:"prompt"`
-	Style        string   `json:"style"`
-	Creator      string   `json:"creator"`
-	Timestamp    *big.Int `json:"timestamp"`
-	IsNFT        bool     `json:"isNFT"`
-	NftTokenID   string   `json:"nftTokenID"`
-	Model        string   `json:"model"`
-	Width        *big.Int `json:"width"`
-	Height       *big.Int `json:"height"`
-	Seed         string   `json:"seed"`
-	CfgScale     *big.Int `json:"cfgScale"`
-	Steps        *big.Int `json:"steps"`
-	ContentHash  string   `json:"contentHash"`
-	Exists       bool     `json:"exists"`
+	ID           *big.Int       `json:"id" abi:"id"`
+	Creator      common.Address `json:"creator"`
+	Prompt       string         `json:"prompt"`
+	IpfsHash     string         `json:"ipfsHash"`
+	Style        string         `json:"style"`
+	CfgScale     *big.Int       `json:"cfgScale"`
+	Steps        *big.Int       `json:"steps"`
+	Height       *big.Int       `json:"height"`
+	Width        *big.Int       `json:"width"`
+	Model        string         `json:"model"`
+	CreatedAt    *big.Int       `json:"createdAt"`
+	IsLicensed   bool           `json:"isLicensed"`
+	LicensePrice *big.Int       `json:"licensePrice"`
+	LicenseTerms string         `json:"licenseTerms"`
+	Licensee     common.Address `json:"licensee"`
+	LicensedAt   *big.Int       `json:"licensedAt"`
+}
+
+// contentFromBinding converts the binding's struct into a Content
+func contentFromBinding(c contracts.LicenZContentContent) *Content {
+	return &Content{
+		ID:           c.Id,
+		Creator:      c.Creator,
+		Prompt:       c.Prompt,
+		IpfsHash:     c.IpfsHash,
+		Style:        c.Style,
+		CfgScale:     c.CfgScale,
+		Steps:        c.Steps,
+		Height:       c.Height,
+		Width:        c.Width,
+		Model:        c.Model,
+		CreatedAt:    c.CreatedAt,
+		IsLicensed:   c.IsLicensed,
+		LicensePrice: c.LicensePrice,
+		LicenseTerms: c.LicenseTerms,
+		Licensee:     c.Licensee,
+		LicensedAt:   c.LicensedAt,
+	}
 }
 
 // NewContentStorageService creates a new blockchain service
@@ -54,178 +88,153 @@ func NewContentStorageService(rpcURL, contractAddress, privateKeyHex string) (*C
 		return nil, fmt.Errorf("failed to connect to Sepolia: %v", err)
 	}
 
-	// Parse private key
-	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(privateKeyHex, "0x"))
+	service, err := newContentStorageService(client, contractAddress, privateKeyHex)
 	if err != nil {
-		return nil, fmt.Errorf("invalid private key: %v", err)
+		client.Close()
+		return nil, err
 	}
+	return service, nil
+}
 
-	// Get public key and address
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		return nil, fmt.Errorf("failed to get public key")
+// newContentStorageService creates a service on top of an existing backend
+func newContentStorageService(client chainBackend, contractAddress, privateKeyHex string) (*ContentStorageService, error) {
+	privateKey, fromAddress, err := parsePrivateKey(privateKeyHex)
+	if err != nil {
+		return nil, err
 	}
 
-	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
-
-	// Parse contract ABI (simplified for this example)
-	contractABI, err := abi.JSON(strings.NewReader(`[
-		{
-			"inputs": [
-				{"name": "ipfsHash", "type": "string"},
-				{"name": "prompt", "type": "string"},
-				{"name": "style", "type": "string"},
-				{"name": "model", "type": "string"},
-				{"name": "width", "type": "uint256"},
-				{"name": "height", "type": "uint256"},
-				{"name": "seed", "type": "string"},
-				{"name": "cfgScale", "type": "uint256"},
-				{"name": "steps", "type": "uint256"},
-				{"name": "contentHash", "type": "string"}
-			],
-			"name": "storeContent",
-			"outputs": [{"name": "", "type": "uint256"}],
-			"stateMutability": "nonpayable",
-			"type": "function"
-		},
-		{
-			"inputs": [{"name": "user", "type": "address"}],
-			"name": "getUserContentIds",
-			"outputs": [{"name": "", "type": "uint256[]"}],
-			"stateMutability": "view",
-			"type": "function"
-		},
-		{
-			"inputs": [{"name": "contentId", "type": "uint256"}],
-			"name": "getContent",
-			"outputs": [{"name": "", "type": "tuple"}],
-			"stateMutability": "view",
-			"type": "function"
-		}
-	]`))
+	contractAddr := common.HexToAddress(contractAddress)
+	contract, err := contracts.NewLicenZContent(contractAddr, client)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse ABI: %v", err)
+		return nil, fmt.Errorf("failed to bind contract: %v", err)
 	}
 
 	return &ContentStorageService{
 		client:       client,
-		contractAddr: common.HexToAddress(contractAddress),
-		contractABI:  contractABI,
+		contract:     contract,
+		contractAddr: contractAddr,
 		privateKey:   privateKey,
 		fromAddress:  fromAddress,
 	}, nil
 }
 
-// StoreContent stores AI-generated content on the blockchain
-func (s *ContentStorageService) StoreContent(content *Content) (*big.Int, error) {
-	// Prepare function call data
-	data, err := s.contractABI.Pack("storeContent",
-		content.IpfsHash,
-		content.Prompt,
-		content.Style,
-		content.Model,
-		content.Width,
-		content.Height,
-		content.Seed,
-		content.CfgScale,
-		content.Steps,
-		content.ContentHash,
-	)
+// parsePrivateKey parses a hex private key and derives its address
+func parsePrivateKey(privateKeyHex string) (*ecdsa.PrivateKey, common.Address, error) {
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(privateKeyHex, "0x"))
 	if err != nil {
-		return nil, fmt.Errorf("failed to pack function call: %v", err)
+		return nil, common.Address{}, fmt.Errorf("invalid private key: %v", err)
 	}
 
-	// Get current gas price
-	gasPrice, err := s.client.SuggestGasPrice(context.Background())
-	if err != nil {
-		return nil, fmt.Errorf("failed to get gas price: %v", err)
+	// Get public key and address
+	publicKey := privateKey.Public()
+	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf("failed to get public key")
 	}
 
-	// Get nonce
-	nonce, err := s.client.PendingNonceAt(context.Background(), s.fromAddress)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get nonce: %v", err)
-	}
+	return privateKey, crypto.PubkeyToAddress(*publicKeyECDSA), nil
+}
 
-	// Estimate gas
-	msg := ethereum.CallMsg{
-		From:  s.fromAddress,
-		To:    &s.contractAddr,
-		Data:  data,
-		Value: big.NewInt(0),
-	}
-	gasLimit, err := s.client.EstimateGas(context.Background(), msg)
+// transactOpts signs transactions with the service key for the backend's chain
+func transactOpts(ctx context.Context, client chainBackend, key *ecdsa.PrivateKey) (*bind.TransactOpts, error) {
+	chainID, err := client.ChainID(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to estimate gas: %v", err)
+		return nil, fmt.Errorf("failed to get chain ID: %v", err)
 	}
-
-	// Create transaction
-	tx := types.NewTransaction(
-		nonce,
-		s.contractAddr,
-		big.NewInt(0),
-		gasLimit,
-		gasPrice,
-		data,
-	)
-
-	// Sign transaction
-	chainID, err := s.client.NetworkID(context.Background())
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get chain ID: %v", err)
+		return nil, fmt.Errorf("failed to create transactor: %v", err)
 	}
+	opts.Context = ctx
+	return opts, nil
+}
 
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), s.privateKey)
+// StoreContent stores AI-generated content on the blockchain and waits for
+// the transaction to be mined
+func (s *ContentStorageService) StoreContent(content *Content) (*StoreResult, error) {
+	opts, err := transactOpts(context.Background(), s.client, s.privateKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to sign transaction: %v", err)
+		return nil, err
 	}
 
-	// Send transaction
-	err = s.client.SendTransaction(context.Background(), signedTx)
+	// The binding estimates gas and fees, signs and sends
+	tx, err := s.contract.CreateContent(opts,
+		content.Prompt,
+		content.IpfsHash,
+		content.Style,
+		content.CfgScale,
+		content.Steps,
+		content.Height,
+		content.Width,
+		content.Model,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send transaction: %v", err)
 	}
 
-	log.Printf("✅ Content stored on blockchain! Transaction: %s", signedTx.Hash().Hex())
+	log.Printf("✅ Content stored on blockchain! Transaction: %s", tx.Hash().Hex())
 
 	// Wait for transaction confirmation
-	receipt, err := s.waitForTransaction(signedTx.Hash())
+	receipt, err := waitForTransaction(s.client, tx.Hash())
 	if err != nil {
 		return nil, fmt.Errorf("transaction failed: %v", err)
 	}
 
-	// Parse the returned content ID from transaction receipt
-	// This is a simplified version - in practice you'd parse the logs
-	contentID := big.NewInt(receipt.BlockNumber.Int64()) // Simplified for example
+	return s.parseStoreReceipt(receipt)
+}
 
-	return contentID, nil
+// StoreResult describes a confirmed createContent transaction. TokenID is
+// the on-chain content ID, the value models.Content.NFTTokenID records.
+type StoreResult struct {
+	TokenID           *big.Int    `json:"tokenId"`
+	TxHash            common.Hash `json:"txHash"`
+	BlockNumber       *big.Int    `json:"blockNumber"`
+	GasUsed           uint64      `json:"gasUsed"`
+	EffectiveGasPrice *big.Int    `json:"effectiveGasPrice"`
 }
 
-// GetUserContent retrieves all content for a specific user
-func (s *ContentStorageService) GetUserContent(userAddress string) ([]*Content, error) {
-	// Get user's content IDs
-	data, err := s.contractABI.Pack("getUserContentIds", common.HexToAddress(userAddress))
-	if err != nil {
-		return nil, fmt.Errorf("failed to pack function call: %v", err)
+// parseStoreReceipt finds the token ID in the events the contract emitted:
+// ContentCreated from every LicenZContent variant, and the ERC-721 mint
+// Transfer from the NFT one. Logs from other contracts are ignored.
+func (s *ContentStorageService) parseStoreReceipt(receipt *types.Receipt) (*StoreResult, error) {
+	var createdID, mintedID *big.Int
+	for _, entry := range receipt.Logs {
+		if entry.Address != s.contractAddr {
+			continue
+		}
+		if created, err := s.contract.ParseContentCreated(*entry); err == nil {
+			createdID = created.TokenId
+		} else if minted, err := s.contract.ParseTransfer(*entry); err == nil && minted.From == (common.Address{}) {
+			mintedID = minted.TokenId
+		}
 	}
 
-	msg := ethereum.CallMsg{
-		From: s.fromAddress,
-		To:   &s.contractAddr,
-		Data: data,
+	tokenID := createdID
+	switch {
+	case createdID == nil && mintedID == nil:
+		return nil, fmt.Errorf("no ContentCreated or Transfer event in transaction %s", receipt.TxHash.Hex())
+	case createdID == nil:
+		tokenID = mintedID
+	case mintedID != nil && mintedID.Cmp(createdID) != 0:
+		return nil, fmt.Errorf("ContentCreated token %s does not match minted token %s", createdID, mintedID)
 	}
 
-	result, err := s.client.CallContract(context.Background(), msg, nil)
+	return &StoreResult{
+		TokenID:           tokenID,
+		TxHash:            receipt.TxHash,
+		BlockNumber:       receipt.BlockNumber,
+		GasUsed:           receipt.GasUsed,
+		EffectiveGasPrice: receipt.EffectiveGasPrice,
+	}, nil
+}
+
+// GetUserContent retrieves all content for a specific user
+func (s *ContentStorageService) GetUserContent(userAddress string) ([]*Content, error) {
+	contentIDs, err := s.contract.GetCreatorContent(s.callOpts(), common.HexToAddress(userAddress))
 	if err != nil {
 		return nil, fmt.Errorf("failed to call contract: %v", err)
 	}
 
-	// Parse the result to get content IDs
-	// This is a simplified version - you'd need to properly decode the array
-	var contentIDs []*big.Int
-	// ... parsing logic here
-
 	// Get content details for each ID
 	var contents []*Content
 	for _, id := range contentIDs {
@@ -242,39 +251,25 @@ func (s *ContentStorageService) GetUserContent(userAddress string) ([]*Content,
 
 // GetContent retrieves a specific content by ID
 func (s *ContentStorageService) GetContent(contentID *big.Int) (*Content, error) {
-	data, err := s.contractABI.Pack("getContent", contentID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to pack function call: %v", err)
-	}
-
-	msg := ethereum.CallMsg{
-		From: s.fromAddress,
-		To:   &s.contractAddr,
-		Data: data,
-	}
-
-	result, err := s.client.CallContract(context.Background(), msg, nil)
+	content, err := s.contract.GetContent(s.callOpts(), contentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call contract: %v", err)
 	}
+	return contentFromBinding(content), nil
+}
 
-	// Parse the result to get content details
-	// This is a simplified version - you'd need to properly decode the struct
-	content := &Content{
-		ID: contentID,
-		// ... parse other fields from result
-	}
-
-	return content, nil
+// callOpts are the options for read-only calls
+func (s *ContentStorageService) callOpts() *bind.CallOpts {
+	return &bind.CallOpts{From: s.fromAddress, Context: context.Background()}
 }
 
 // waitForTransaction waits for a transaction to be mined
-func (s *ContentStorageService) waitForTransaction(txHash common.Hash) (*types.Receipt, error) {
+func waitForTransaction(client chainBackend, txHash common.Hash) (*types.Receipt, error) {
 	for {
-		receipt, err := s.client.TransactionReceipt(context.Background(), txHash)
+		receipt, err := client.TransactionReceipt(context.Background(), txHash)
 		if err != nil {
-			if err == ethereum.NotFound {
-				// Transaction not yet mined, wait a bit
+			// Not yet mined, or mined but the node has not indexed it yet
+			if err == ethereum.NotFound || strings.Contains(err.Error(), "transaction indexing is in progress") {
 				time.Sleep(2 * time.Second)
 				continue
 			}
@@ -289,9 +284,101 @@ func (s *ContentStorageService) waitForTransaction(txHash common.Hash) (*types.R
 	}
 }
 
+// ErrTxPending is returned for a transaction that has not been mined yet
+var ErrTxPending = errors.New("transaction is not mined yet")
+
+// ErrTxFailed is returned for a transaction that was mined but reverted
+var ErrTxFailed = errors.New("transaction failed with status 0")
+
+// lookupReceipt returns the receipt of a mined, successful transaction
+// without waiting for it
+func lookupReceipt(client chainBackend, txHash common.Hash) (*types.Receipt, error) {
+	receipt, err := client.TransactionReceipt(context.Background(), txHash)
+	if err != nil {
+		if err == ethereum.NotFound || strings.Contains(err.Error(), "transaction indexing is in progress") {
+			return nil, ErrTxPending
+		}
+		return nil, fmt.Errorf("failed to get receipt: %v", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, ErrTxFailed
+	}
+	return receipt, nil
+}
+
+// PreparedTx is an unsigned transaction for a user's wallet to sign and
+// send. It marshals to the shape eth_sendTransaction takes, so the frontend
+// can pass it straight to the wallet.
+type PreparedTx struct {
+	From                 common.Address `json:"from"`
+	To                   common.Address `json:"to"`
+	Data                 hexutil.Bytes  `json:"data"`
+	Value                *hexutil.Big   `json:"value"`
+	Gas                  hexutil.Uint64 `json:"gas"`
+	Nonce                hexutil.Uint64 `json:"nonce"`
+	ChainID              *hexutil.Big   `json:"chainId"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas,omitempty"`
+	GasPrice             *hexutil.Big   `json:"gasPrice,omitempty"`
+}
+
+// prepareTx fills in gas, nonce and fees for a call from one address.
+// Chains with a base fee get EIP-1559 fees, others a legacy gas price.
+func prepareTx(client chainBackend, from, to common.Address, data []byte, value *big.Int) (*PreparedTx, error) {
+	ctx := context.Background()
+	if value == nil {
+		value = new(big.Int)
+	}
+
+	gas, err := client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &to, Value: value, Data: data})
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate gas: %v", err)
+	}
+	nonce, err := client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce: %v", err)
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %v", err)
+	}
+
+	tx := &PreparedTx{
+		From:    from,
+		To:      to,
+		Data:    data,
+		Value:   (*hexutil.Big)(value),
+		Gas:     hexutil.Uint64(gas),
+		Nonce:   hexutil.Uint64(nonce),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %v", err)
+	}
+	if head.BaseFee != nil {
+		tip, err := client.SuggestGasTipCap(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to suggest gas tip: %v", err)
+		}
+		// Room for the base fee to double before the transaction is stuck
+		feeCap := new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+		tx.MaxFeePerGas = (*hexutil.Big)(feeCap)
+		tx.MaxPriorityFeePerGas = (*hexutil.Big)(tip)
+	} else {
+		price, err := client.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to suggest gas price: %v", err)
+		}
+		tx.GasPrice = (*hexutil.Big)(price)
+	}
+	return tx, nil
+}
+
 // Close closes the blockchain connection
 func (s *ContentStorageService) Close() {
-	if s.client != nil {
-		s.client.Close()
+	if closer, ok := s.client.(interface{ Close() }); ok {
+		closer.Close()
 	}
 }
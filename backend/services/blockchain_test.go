@@ -0,0 +1,420 @@
+package services
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"licenz-backend/contracts"
+)
+
+// contentArtifact is where `npx hardhat compile` writes LicenZContent
+const contentArtifact = "../../blockchain/artifacts/contracts/LicenZContent.sol/LicenZContent.json"
+
+const testKey = "b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291"
+
+func fixtureContent() *Content {
+	return &Content{
+		ID:           big.NewInt(7),
+		Creator:      common.HexToAddress("0x00000000000000000000000000000000000000aa"),
+		Prompt:       "A lighthouse at dusk",
+		IpfsHash:     "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+		Style:        "photographic",
+		CfgScale:     big.NewInt(7),
+		Steps:        big.NewInt(30),
+		Height:       big.NewInt(1024),
+		Width:        big.NewInt(768),
+		Model:        "stable-diffusion-xl-1024-v1-0",
+		CreatedAt:    big.NewInt(1700000000),
+		IsLicensed:   true,
+		LicensePrice: big.NewInt(1e15),
+		LicenseTerms: "commercial",
+		Licensee:     common.HexToAddress("0x00000000000000000000000000000000000000bb"),
+		LicensedAt:   big.NewInt(1700000100),
+	}
+}
+
+// stubRoute is one function of a stub contract: a call with selector
+// emits a log with topics and event data, if topics is set, and returns data
+type stubRoute struct {
+	selector []byte
+	data     []byte
+	topics   []common.Hash
+	event    []byte
+}
+
+// stubCode assembles contract code that answers each route's selector and
+// reverts on anything else
+func stubCode(routes ...stubRoute) []byte {
+	u16 := func(v int) []byte { return binary.BigEndian.AppendUint16(nil, uint16(v)) }
+	copyToMemory := func(code []byte, offset, length int) []byte {
+		// CODECOPY(0, offset, length)
+		code = append(code, 0x61)
+		code = append(code, u16(length)...)
+		code = append(code, 0x61)
+		code = append(code, u16(offset)...)
+		return append(code, 0x60, 0x00, 0x39)
+	}
+	segmentLen := func(r stubRoute) int {
+		n := 1 + 15
+		if len(r.topics) > 0 {
+			n += 9 + 33*len(r.topics) + 6
+		}
+		return n
+	}
+
+	const dispatchLen = 11
+	header := 6 + dispatchLen*len(routes) + 4
+	dataStart := header
+	for _, r := range routes {
+		dataStart += segmentLen(r)
+	}
+
+	// selector = calldata[0:4]
+	code := []byte{0x60, 0x00, 0x35, 0x60, 0xe0, 0x1c}
+	dest := header
+	for _, r := range routes {
+		// DUP1 PUSH4 selector EQ PUSH2 dest JUMPI
+		code = append(code, 0x80, 0x63)
+		code = append(code, r.selector...)
+		code = append(code, 0x14, 0x61)
+		code = append(code, u16(dest)...)
+		code = append(code, 0x57)
+		dest += segmentLen(r)
+	}
+	// REVERT(0, 0)
+	code = append(code, 0x60, 0x00, 0x80, 0xfd)
+
+	offset := dataStart
+	for _, r := range routes {
+		code = append(code, 0x5b) // JUMPDEST
+		if len(r.topics) > 0 {
+			// LOGn(0, len(event), topics...)
+			code = copyToMemory(code, offset, len(r.event))
+			for i := len(r.topics) - 1; i >= 0; i-- {
+				code = append(code, 0x7f)
+				code = append(code, r.topics[i].Bytes()...)
+			}
+			code = append(code, 0x61)
+			code = append(code, u16(len(r.event))...)
+			code = append(code, 0x60, 0x00, 0xa0+byte(len(r.topics)))
+			offset += len(r.event)
+		}
+		// RETURN(0, len(data))
+		code = copyToMemory(code, offset, len(r.data))
+		code = append(code, 0x61)
+		code = append(code, u16(len(r.data))...)
+		code = append(code, 0x60, 0x00, 0xf3)
+		offset += len(r.data)
+	}
+	for _, r := range routes {
+		code = append(code, r.event...)
+		code = append(code, r.data...)
+	}
+	return code
+}
+
+// mineUntilDone commits a block every few milliseconds, so that a pending
+// transaction gets mined, until the returned stop function is called
+func mineUntilDone(backend *simulated.Backend) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(20 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				backend.Commit()
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+func newTestService(t *testing.T, client chainBackend, contract common.Address) *ContentStorageService {
+	t.Helper()
+	service, err := newContentStorageService(client, contract.Hex(), testKey)
+	if err != nil {
+		t.Fatalf("newContentStorageService: %v", err)
+	}
+	return service
+}
+
+func TestGetContentDecodesTuple(t *testing.T) {
+	parsed, err := contracts.LicenZContentMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := fixtureContent()
+
+	contentData, err := parsed.Methods["getContent"].Outputs.Pack(want)
+	if err != nil {
+		t.Fatalf("pack content: %v", err)
+	}
+	idsData, err := parsed.Methods["getCreatorContent"].Outputs.Pack([]*big.Int{big.NewInt(7), big.NewInt(9)})
+	if err != nil {
+		t.Fatalf("pack ids: %v", err)
+	}
+
+	contract := common.HexToAddress("0x000000000000000000000000000000000000c0de")
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		contract: {Code: stubCode(
+			stubRoute{selector: parsed.Methods["getContent"].ID, data: contentData},
+			stubRoute{selector: parsed.Methods["getCreatorContent"].ID, data: idsData},
+		)},
+	})
+	defer backend.Close()
+	service := newTestService(t, backend.Client(), contract)
+
+	got, err := service.GetContent(big.NewInt(7))
+	if err != nil {
+		t.Fatalf("GetContent: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetContent = %+v, want %+v", got, want)
+	}
+
+	contents, err := service.GetUserContent(want.Creator.Hex())
+	if err != nil {
+		t.Fatalf("GetUserContent: %v", err)
+	}
+	if len(contents) != 2 {
+		t.Fatalf("GetUserContent returned %d items, want 2", len(contents))
+	}
+	if !reflect.DeepEqual(contents[0], want) {
+		t.Errorf("GetUserContent[0] = %+v, want %+v", contents[0], want)
+	}
+}
+
+func TestGetContentRejectsBadData(t *testing.T) {
+	parsed, err := contracts.LicenZContentMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// getContent answers with truncated data; anything else reverts
+	contract := common.HexToAddress("0x000000000000000000000000000000000000c0de")
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		contract: {Code: stubCode(stubRoute{selector: parsed.Methods["getContent"].ID, data: []byte{0x01, 0x02}})},
+	})
+	defer backend.Close()
+	service := newTestService(t, backend.Client(), contract)
+
+	if _, err := service.GetContent(big.NewInt(1)); err == nil {
+		t.Error("GetContent accepted truncated data")
+	}
+	if _, err := service.GetUserContent(fixtureContent().Creator.Hex()); err == nil {
+		t.Error("GetUserContent succeeded against a reverting contract")
+	}
+}
+
+func TestStoreContentReadsTokenID(t *testing.T) {
+	parsed, err := contracts.LicenZContentMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, _ := crypto.HexToECDSA(testKey)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	want := fixtureContent()
+
+	created := parsed.Events["ContentCreated"]
+	event, err := created.Inputs.NonIndexed().Pack(want.Prompt, want.IpfsHash, want.CreatedAt)
+	if err != nil {
+		t.Fatalf("pack event: %v", err)
+	}
+	returned, err := parsed.Methods["createContent"].Outputs.Pack(big.NewInt(42))
+	if err != nil {
+		t.Fatalf("pack result: %v", err)
+	}
+
+	contract := common.HexToAddress("0x000000000000000000000000000000000000c0de")
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		from: {Balance: big.NewInt(1e18)},
+		contract: {Code: stubCode(stubRoute{
+			selector: parsed.Methods["createContent"].ID,
+			data:     returned,
+			topics:   []common.Hash{created.ID, common.BigToHash(big.NewInt(42)), common.BytesToHash(from.Bytes())},
+			event:    event,
+		})},
+	})
+	defer backend.Close()
+	service := newTestService(t, backend.Client(), contract)
+
+	stop := mineUntilDone(backend)
+	result, err := service.StoreContent(want)
+	stop()
+	if err != nil {
+		t.Fatalf("StoreContent: %v", err)
+	}
+
+	if result.TokenID.Int64() != 42 {
+		t.Errorf("TokenID = %v, want 42", result.TokenID)
+	}
+	if result.TxHash == (common.Hash{}) || result.BlockNumber.Sign() <= 0 || result.GasUsed == 0 {
+		t.Errorf("result = %+v, want a tx hash, block number and gas used", result)
+	}
+	if result.EffectiveGasPrice == nil || result.EffectiveGasPrice.Sign() <= 0 {
+		t.Errorf("EffectiveGasPrice = %v, want a positive price", result.EffectiveGasPrice)
+	}
+}
+
+func TestParseStoreReceipt(t *testing.T) {
+	contract := common.HexToAddress("0x000000000000000000000000000000000000c0de")
+	other := common.HexToAddress("0x000000000000000000000000000000000000beef")
+	service := newTestService(t, nil, contract)
+	parsed, err := contracts.LicenZContentMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	createdID := parsed.Events["ContentCreated"].ID
+	transferID := parsed.Events["Transfer"].ID
+	createdData, err := parsed.Events["ContentCreated"].Inputs.NonIndexed().Pack("prompt", "Qm", big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	creator := common.BytesToHash(common.HexToAddress("0xaa").Bytes())
+	token := func(id int64) common.Hash { return common.BigToHash(big.NewInt(id)) }
+
+	createdLog := func(addr common.Address, id int64) *types.Log {
+		return &types.Log{Address: addr, Topics: []common.Hash{createdID, token(id), creator}, Data: createdData}
+	}
+	mintLog := func(id int64) *types.Log {
+		return &types.Log{Address: contract, Topics: []common.Hash{transferID, {}, creator, token(id)}}
+	}
+
+	tests := []struct {
+		name    string
+		logs    []*types.Log
+		want    int64
+		wantErr bool
+	}{
+		{"content created", []*types.Log{createdLog(contract, 3)}, 3, false},
+		{"created and minted", []*types.Log{mintLog(5), createdLog(contract, 5)}, 5, false},
+		{"minted only", []*types.Log{mintLog(8)}, 8, false},
+		{"other contract ignored", []*types.Log{createdLog(other, 9), createdLog(contract, 4)}, 4, false},
+		{"ids disagree", []*types.Log{mintLog(1), createdLog(contract, 2)}, 0, true},
+		{"no events", nil, 0, true},
+		{"only other contracts", []*types.Log{createdLog(other, 9)}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receipt := &types.Receipt{
+				Logs:              tt.logs,
+				TxHash:            common.HexToHash("0x01"),
+				BlockNumber:       big.NewInt(10),
+				GasUsed:           21000,
+				EffectiveGasPrice: big.NewInt(1e9),
+			}
+			result, err := service.parseStoreReceipt(receipt)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseStoreReceipt = %+v, want error", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseStoreReceipt: %v", err)
+			}
+			if result.TokenID.Int64() != tt.want {
+				t.Errorf("TokenID = %v, want %d", result.TokenID, tt.want)
+			}
+			if result.TxHash != receipt.TxHash || result.BlockNumber.Int64() != 10 ||
+				result.GasUsed != 21000 || result.EffectiveGasPrice.Int64() != 1e9 {
+				t.Errorf("result = %+v, does not carry the receipt's details", result)
+			}
+		})
+	}
+}
+
+// TestCompiledContract runs against the real LicenZContent bytecode. It is
+// skipped until the contracts have been compiled with Hardhat.
+func TestCompiledContract(t *testing.T) {
+	raw, err := os.ReadFile(contentArtifact)
+	if os.IsNotExist(err) {
+		t.Skip("LicenZContent artifact not found; run `npx hardhat compile` in blockchain/")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	var artifact struct {
+		ABI      json.RawMessage `json:"abi"`
+		Bytecode string          `json:"bytecode"`
+	}
+	if err := json.Unmarshal(raw, &artifact); err != nil {
+		t.Fatalf("parse artifact: %v", err)
+	}
+	compiled, err := abi.JSON(strings.NewReader(string(artifact.ABI)))
+	if err != nil {
+		t.Fatalf("parse artifact ABI: %v", err)
+	}
+
+	key, _ := crypto.HexToECDSA(testKey)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		from: {Balance: big.NewInt(1e18)},
+	})
+	defer backend.Close()
+	client := backend.Client()
+
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	address, _, _, err := bind.DeployContract(opts, compiled, common.FromHex(artifact.Bytecode), client)
+	if err != nil {
+		t.Fatalf("deploy: %v", err)
+	}
+	backend.Commit()
+
+	service := newTestService(t, client, address)
+	want := fixtureContent()
+
+	stop := mineUntilDone(backend)
+	result, err := service.StoreContent(want)
+	stop()
+	if err != nil {
+		t.Fatalf("StoreContent: %v", err)
+	}
+	if result.TokenID.Int64() != 1 {
+		t.Errorf("StoreContent token = %v, want 1", result.TokenID)
+	}
+
+	contents, err := service.GetUserContent(from.Hex())
+	if err != nil {
+		t.Fatalf("GetUserContent: %v", err)
+	}
+	if len(contents) != 1 {
+		t.Fatalf("GetUserContent returned %d items, want 1", len(contents))
+	}
+	got := contents[0]
+	if got.ID.Int64() != 1 || got.Creator != from {
+		t.Errorf("got id %v creator %s, want 1 and %s", got.ID, got.Creator.Hex(), from.Hex())
+	}
+	if got.Prompt != want.Prompt || got.IpfsHash != want.IpfsHash || got.Style != want.Style ||
+		got.Model != want.Model || got.Width.Cmp(want.Width) != 0 || got.Height.Cmp(want.Height) != 0 ||
+		got.CfgScale.Cmp(want.CfgScale) != 0 || got.Steps.Cmp(want.Steps) != 0 {
+		t.Errorf("GetUserContent = %+v, want the fields of %+v", got, want)
+	}
+	if got.CreatedAt.Sign() == 0 || got.IsLicensed {
+		t.Errorf("got createdAt %v licensed %v, want a timestamp and unlicensed", got.CreatedAt, got.IsLicensed)
+	}
+}
@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"licenz-backend/contracts"
+)
+
+var (
+	// ErrLicenseNotFound is returned for a license ID the contract never issued
+	ErrLicenseNotFound = errors.New("license does not exist")
+	// ErrLicenseInactive is returned for a license its creator deactivated
+	ErrLicenseInactive = errors.New("license is not active")
+	// ErrLicensePurchased is returned for a license that was already sold
+	ErrLicensePurchased = errors.New("license already purchased")
+	// ErrCreatorPurchase is returned when a creator tries to buy their own license
+	ErrCreatorPurchase = errors.New("creator cannot purchase their own license")
+	// ErrNotLicenseCreator is returned when someone else tries to deactivate a license
+	ErrNotLicenseCreator = errors.New("only the creator can deactivate a license")
+)
+
+// LicenseService reads a deployed LicenZLicense contract and prepares the
+// transactions that change it. LicenZLicense pays the license's creator and
+// checks the sender on every write, so writes are never signed by the
+// server: they are returned unsigned for the user's wallet.
+type LicenseService struct {
+	client       chainBackend
+	contract     *contracts.LicenZLicense
+	contractAddr common.Address
+	abi          *abi.ABI
+}
+
+// License is a LicenZLicense record as the API returns it
+type License struct {
+	ID          *big.Int       `json:"id"`
+	ContentHash common.Hash    `json:"contentHash"`
+	Price       *big.Int       `json:"price"`
+	Terms       string         `json:"terms"`
+	Creator     common.Address `json:"creator"`
+	IsActive    bool           `json:"isActive"`
+	CreatedAt   *big.Int       `json:"createdAt"`
+	PurchasedAt *big.Int       `json:"purchasedAt"`
+	Purchaser   common.Address `json:"purchaser"`
+	IsPurchased bool           `json:"isPurchased"`
+}
+
+// Valid reports whether the license can still be purchased, as the
+// contract's isLicenseValid does
+func (l *License) Valid() bool {
+	return l.IsActive && !l.IsPurchased
+}
+
+// NewLicenseService connects to rpcURL and binds the LicenZLicense contract
+func NewLicenseService(rpcURL, contractAddress string) (*LicenseService, error) {
+	client, err := ethclient.Dial(rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RPC: %v", err)
+	}
+
+	service, err := newLicenseService(client, contractAddress)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	return service, nil
+}
+
+// newLicenseService creates a service on top of an existing backend
+func newLicenseService(client chainBackend, contractAddress string) (*LicenseService, error) {
+	if !common.IsHexAddress(contractAddress) {
+		return nil, fmt.Errorf("invalid contract address %q", contractAddress)
+	}
+	contractAddr := common.HexToAddress(contractAddress)
+
+	contract, err := contracts.NewLicenZLicense(contractAddr, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract: %v", err)
+	}
+	parsed, err := contracts.LicenZLicenseMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract ABI: %v", err)
+	}
+
+	return &LicenseService{
+		client:       client,
+		contract:     contract,
+		contractAddr: contractAddr,
+		abi:          parsed,
+	}, nil
+}
+
+// ContractAddress is the address of the bound LicenZLicense contract
+func (s *LicenseService) ContractAddress() common.Address {
+	return s.contractAddr
+}
+
+// GetLicense retrieves a license by ID
+func (s *LicenseService) GetLicense(licenseID *big.Int) (*License, error) {
+	// The public mapping getter returns an empty record for unknown IDs,
+	// where getLicense reverts without saying why
+	l, err := s.contract.Licenses(s.callOpts(), licenseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %v", err)
+	}
+	if l.LicenseId == nil || l.LicenseId.Sign() == 0 {
+		return nil, ErrLicenseNotFound
+	}
+
+	return &License{
+		ID:          l.LicenseId,
+		ContentHash: l.ContentHash,
+		Price:       l.Price,
+		Terms:       l.Terms,
+		Creator:     l.Creator,
+		IsActive:    l.IsActive,
+		CreatedAt:   l.CreatedAt,
+		PurchasedAt: l.PurchasedAt,
+		Purchaser:   l.Purchaser,
+		IsPurchased: l.IsPurchased,
+	}, nil
+}
+
+// IsLicenseValid reports whether a license exists, is active and unsold
+func (s *LicenseService) IsLicenseValid(licenseID *big.Int) (bool, error) {
+	valid, err := s.contract.IsLicenseValid(s.callOpts(), licenseID)
+	if err != nil {
+		return false, fmt.Errorf("failed to call contract: %v", err)
+	}
+	return valid, nil
+}
+
+// LicensesForContent retrieves every license created for a content hash
+func (s *LicenseService) LicensesForContent(contentHash common.Hash) ([]*License, error) {
+	ids, err := s.contract.GetLicensesForContent(s.callOpts(), contentHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %v", err)
+	}
+	return s.getLicenses(ids), nil
+}
+
+// LicensesByCreator retrieves every license an address created
+func (s *LicenseService) LicensesByCreator(creator common.Address) ([]*License, error) {
+	ids, err := s.contract.GetLicensesByCreator(s.callOpts(), creator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %v", err)
+	}
+	return s.getLicenses(ids), nil
+}
+
+// LicensesByPurchaser retrieves every license an address bought
+func (s *LicenseService) LicensesByPurchaser(purchaser common.Address) ([]*License, error) {
+	ids, err := s.contract.GetLicensesByPurchaser(s.callOpts(), purchaser)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %v", err)
+	}
+	return s.getLicenses(ids), nil
+}
+
+// getLicenses retrieves the details of each license ID
+func (s *LicenseService) getLicenses(ids []*big.Int) []*License {
+	licenses := make([]*License, 0, len(ids))
+	for _, id := range ids {
+		license, err := s.GetLicense(id)
+		if err != nil {
+			log.Printf("Warning: failed to get license %s: %v", id.String(), err)
+			continue
+		}
+		licenses = append(licenses, license)
+	}
+	return licenses
+}
+
+// BuildCreateTx prepares a createLicense transaction for the creator's
+// wallet. The contract records the sender as the license's creator and
+// pays them on every sale.
+func (s *LicenseService) BuildCreateTx(creator common.Address, contentHash common.Hash, price *big.Int, terms string) (*PreparedTx, error) {
+	data, err := s.abi.Pack("createLicense", contentHash, price, terms)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode createLicense: %v", err)
+	}
+	return prepareTx(s.client, creator, s.contractAddr, data, nil)
+}
+
+// BuildPurchaseTx prepares a purchaseLicense transaction for the buyer's
+// wallet, paying the license's price. The checks the contract would revert
+// on are made first, so the caller gets a reason rather than a failed gas
+// estimate.
+func (s *LicenseService) BuildPurchaseTx(licenseID *big.Int, buyer common.Address) (*PreparedTx, error) {
+	license, err := s.GetLicense(licenseID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case !license.IsActive:
+		return nil, ErrLicenseInactive
+	case license.IsPurchased:
+		return nil, ErrLicensePurchased
+	case license.Creator == buyer:
+		return nil, ErrCreatorPurchase
+	}
+
+	data, err := s.abi.Pack("purchaseLicense", licenseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode purchaseLicense: %v", err)
+	}
+	return prepareTx(s.client, buyer, s.contractAddr, data, license.Price)
+}
+
+// BuildDeactivateTx prepares a deactivateLicense transaction for the
+// creator's wallet, the only sender the contract accepts
+func (s *LicenseService) BuildDeactivateTx(licenseID *big.Int, creator common.Address) (*PreparedTx, error) {
+	license, err := s.GetLicense(licenseID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case license.Creator != creator:
+		return nil, ErrNotLicenseCreator
+	case !license.IsActive:
+		return nil, ErrLicenseInactive
+	case license.IsPurchased:
+		return nil, ErrLicensePurchased
+	}
+
+	data, err := s.abi.Pack("deactivateLicense", licenseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode deactivateLicense: %v", err)
+	}
+	return prepareTx(s.client, creator, s.contractAddr, data, nil)
+}
+
+// ConfirmLicense looks up a createLicense transaction sent from a wallet
+// and returns the license it created. It does not wait: ErrTxPending means
+// the transaction has not been mined yet.
+func (s *LicenseService) ConfirmLicense(txHash common.Hash) (*License, error) {
+	receipt, err := lookupReceipt(s.client, txHash)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range receipt.Logs {
+		if entry.Address != s.contractAddr {
+			continue
+		}
+		if created, err := s.contract.ParseLicenseCreated(*entry); err == nil {
+			return s.GetLicense(created.LicenseId)
+		}
+	}
+	return nil, fmt.Errorf("no LicenseCreated event in transaction %s", txHash.Hex())
+}
+
+// callOpts are the options for read-only calls
+func (s *LicenseService) callOpts() *bind.CallOpts {
+	return &bind.CallOpts{Context: context.Background()}
+}
+
+// Close closes the blockchain connection
+func (s *LicenseService) Close() {
+	if closer, ok := s.client.(interface{ Close() }); ok {
+		closer.Close()
+	}
+}
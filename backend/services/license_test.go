@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"licenz-backend/contracts"
+)
+
+func fixtureLicense() *License {
+	return &License{
+		ID:          big.NewInt(3),
+		ContentHash: common.HexToHash("0xba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"),
+		Price:       big.NewInt(1e15),
+		Terms:       "Commercial use, no resale",
+		Creator:     common.HexToAddress("0x00000000000000000000000000000000000000aa"),
+		IsActive:    true,
+		CreatedAt:   big.NewInt(1700000000),
+		PurchasedAt: big.NewInt(0),
+		Purchaser:   common.Address{},
+		IsPurchased: false,
+	}
+}
+
+// sameLicense compares licenses by value; a decoded zero *big.Int is not
+// reflect.DeepEqual to big.NewInt(0)
+func sameLicense(a, b *License) bool {
+	x, _ := json.Marshal(a)
+	y, _ := json.Marshal(b)
+	return string(x) == string(y)
+}
+
+func licenseABI(t *testing.T) *abi.ABI {
+	t.Helper()
+	parsed, err := contracts.LicenZLicenseMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+// licenseRoute answers the licenses(uint256) getter with l
+func licenseRoute(t *testing.T, parsed *abi.ABI, l *License) stubRoute {
+	t.Helper()
+	data, err := parsed.Methods["licenses"].Outputs.Pack(
+		l.ID, l.ContentHash, l.Price, l.Terms, l.Creator, l.IsActive,
+		l.CreatedAt, l.PurchasedAt, l.Purchaser, l.IsPurchased,
+	)
+	if err != nil {
+		t.Fatalf("pack license: %v", err)
+	}
+	return stubRoute{selector: parsed.Methods["licenses"].ID, data: data}
+}
+
+// newLicenseBackend deploys a stub LicenZLicense and funds the test key
+func newLicenseBackend(t *testing.T, routes ...stubRoute) (*simulated.Backend, *LicenseService) {
+	t.Helper()
+	key, _ := crypto.HexToECDSA(testKey)
+	contract := common.HexToAddress("0x000000000000000000000000000000000000c0de")
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		crypto.PubkeyToAddress(key.PublicKey): {Balance: big.NewInt(1e18)},
+		contract:                              {Code: stubCode(routes...)},
+	})
+	t.Cleanup(func() { backend.Close() })
+
+	service, err := newLicenseService(backend.Client(), contract.Hex())
+	if err != nil {
+		t.Fatalf("newLicenseService: %v", err)
+	}
+	return backend, service
+}
+
+// sendPrepared signs a prepared transaction with the test key, sends it and
+// mines it
+func sendPrepared(t *testing.T, backend *simulated.Backend, prepared *PreparedTx) common.Hash {
+	t.Helper()
+	key, _ := crypto.HexToECDSA(testKey)
+	to := prepared.To
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(prepared.ChainID.ToInt()), &types.DynamicFeeTx{
+		ChainID:   prepared.ChainID.ToInt(),
+		Nonce:     uint64(prepared.Nonce),
+		GasTipCap: prepared.MaxPriorityFeePerGas.ToInt(),
+		GasFeeCap: prepared.MaxFeePerGas.ToInt(),
+		Gas:       uint64(prepared.Gas),
+		To:        &to,
+		Value:     prepared.Value.ToInt(),
+		Data:      prepared.Data,
+	})
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	if err := backend.Client().SendTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	backend.Commit()
+	return tx.Hash()
+}
+
+func TestGetLicenseDecodes(t *testing.T) {
+	parsed := licenseABI(t)
+	want := fixtureLicense()
+	idsData, err := parsed.Methods["getLicensesForContent"].Outputs.Pack([]*big.Int{big.NewInt(3), big.NewInt(4)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	validData, err := parsed.Methods["isLicenseValid"].Outputs.Pack(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, service := newLicenseBackend(t,
+		licenseRoute(t, parsed, want),
+		stubRoute{selector: parsed.Methods["getLicensesForContent"].ID, data: idsData},
+		stubRoute{selector: parsed.Methods["isLicenseValid"].ID, data: validData},
+	)
+
+	got, err := service.GetLicense(big.NewInt(3))
+	if err != nil {
+		t.Fatalf("GetLicense: %v", err)
+	}
+	if !sameLicense(got, want) {
+		t.Errorf("GetLicense = %+v, want %+v", got, want)
+	}
+
+	licenses, err := service.LicensesForContent(want.ContentHash)
+	if err != nil {
+		t.Fatalf("LicensesForContent: %v", err)
+	}
+	if len(licenses) != 2 {
+		t.Errorf("LicensesForContent returned %d licenses, want 2", len(licenses))
+	}
+
+	valid, err := service.IsLicenseValid(big.NewInt(3))
+	if err != nil || !valid {
+		t.Errorf("IsLicenseValid = %v, %v, want true", valid, err)
+	}
+}
+
+func TestGetLicenseNotFound(t *testing.T) {
+	parsed := licenseABI(t)
+	empty := &License{
+		ID: new(big.Int), Price: new(big.Int), CreatedAt: new(big.Int), PurchasedAt: new(big.Int),
+	}
+	_, service := newLicenseBackend(t, licenseRoute(t, parsed, empty))
+
+	if _, err := service.GetLicense(big.NewInt(99)); !errors.Is(err, ErrLicenseNotFound) {
+		t.Errorf("GetLicense = %v, want ErrLicenseNotFound", err)
+	}
+	if _, err := service.BuildPurchaseTx(big.NewInt(99), common.HexToAddress("0xbb")); !errors.Is(err, ErrLicenseNotFound) {
+		t.Errorf("BuildPurchaseTx = %v, want ErrLicenseNotFound", err)
+	}
+}
+
+func TestBuildPurchaseTx(t *testing.T) {
+	parsed := licenseABI(t)
+	key, _ := crypto.HexToECDSA(testKey)
+	buyer := crypto.PubkeyToAddress(key.PublicKey)
+	license := fixtureLicense()
+	backend, service := newLicenseBackend(t,
+		licenseRoute(t, parsed, license),
+		stubRoute{selector: parsed.Methods["purchaseLicense"].ID},
+	)
+
+	prepared, err := service.BuildPurchaseTx(license.ID, buyer)
+	if err != nil {
+		t.Fatalf("BuildPurchaseTx: %v", err)
+	}
+	wantData, _ := parsed.Pack("purchaseLicense", license.ID)
+	if prepared.From != buyer || prepared.To != service.ContractAddress() || string(prepared.Data) != string(wantData) {
+		t.Errorf("prepared = %+v, want a purchaseLicense call from %s", prepared, buyer.Hex())
+	}
+	if prepared.Value.ToInt().Cmp(license.Price) != 0 {
+		t.Errorf("Value = %v, want the price %v", prepared.Value.ToInt(), license.Price)
+	}
+	if prepared.ChainID.ToInt().Int64() != 1337 || prepared.Gas == 0 || prepared.MaxFeePerGas == nil || prepared.GasPrice != nil {
+		t.Errorf("prepared = %+v, want chain 1337, gas and EIP-1559 fees", prepared)
+	}
+
+	// The wallet only has to sign it
+	txHash := sendPrepared(t, backend, prepared)
+	receipt, err := backend.Client().TransactionReceipt(context.Background(), txHash)
+	if err != nil || receipt.Status != types.ReceiptStatusSuccessful {
+		t.Errorf("receipt = %+v, %v, want a successful transaction", receipt, err)
+	}
+}
+
+func TestBuildPurchaseTxChecksLicense(t *testing.T) {
+	parsed := licenseABI(t)
+	buyer := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+
+	tests := []struct {
+		name   string
+		change func(*License)
+		buyer  common.Address
+		want   error
+	}{
+		{"inactive", func(l *License) { l.IsActive = false }, buyer, ErrLicenseInactive},
+		{"purchased", func(l *License) { l.IsPurchased, l.Purchaser = true, buyer }, buyer, ErrLicensePurchased},
+		{"own license", func(l *License) {}, fixtureLicense().Creator, ErrCreatorPurchase},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			license := fixtureLicense()
+			tt.change(license)
+			_, service := newLicenseBackend(t, licenseRoute(t, parsed, license))
+
+			if _, err := service.BuildPurchaseTx(license.ID, tt.buyer); !errors.Is(err, tt.want) {
+				t.Errorf("BuildPurchaseTx = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	_, service := newLicenseBackend(t, licenseRoute(t, parsed, fixtureLicense()))
+	if _, err := service.BuildDeactivateTx(big.NewInt(3), buyer); !errors.Is(err, ErrNotLicenseCreator) {
+		t.Errorf("BuildDeactivateTx = %v, want ErrNotLicenseCreator", err)
+	}
+}
+
+func TestConfirmLicense(t *testing.T) {
+	parsed := licenseABI(t)
+	key, _ := crypto.HexToECDSA(testKey)
+	creator := crypto.PubkeyToAddress(key.PublicKey)
+	license := fixtureLicense()
+	license.Creator = creator
+
+	created := parsed.Events["LicenseCreated"]
+	event, err := created.Inputs.NonIndexed().Pack(license.Price, license.Terms, license.CreatedAt)
+	if err != nil {
+		t.Fatalf("pack event: %v", err)
+	}
+	returned, err := parsed.Methods["createLicense"].Outputs.Pack(license.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend, service := newLicenseBackend(t,
+		licenseRoute(t, parsed, license),
+		stubRoute{
+			selector: parsed.Methods["createLicense"].ID,
+			data:     returned,
+			topics:   []common.Hash{created.ID, common.BigToHash(license.ID), license.ContentHash, common.BytesToHash(creator.Bytes())},
+			event:    event,
+		},
+	)
+
+	if _, err := service.ConfirmLicense(common.HexToHash("0x01")); !errors.Is(err, ErrTxPending) {
+		t.Errorf("ConfirmLicense of an unknown transaction = %v, want ErrTxPending", err)
+	}
+
+	prepared, err := service.BuildCreateTx(creator, license.ContentHash, license.Price, license.Terms)
+	if err != nil {
+		t.Fatalf("BuildCreateTx: %v", err)
+	}
+	txHash := sendPrepared(t, backend, prepared)
+
+	got, err := service.ConfirmLicense(txHash)
+	if err != nil {
+		t.Fatalf("ConfirmLicense: %v", err)
+	}
+	if !sameLicense(got, license) {
+		t.Errorf("ConfirmLicense = %+v, want %+v", got, license)
+	}
+}
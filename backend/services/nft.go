@@ -0,0 +1,292 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"licenz-backend/contracts"
+)
+
+var (
+	// ErrContentMinted is returned when a content hash already has a token
+	ErrContentMinted = errors.New("content already minted")
+	// ErrNotMinted is returned when a content hash has no token
+	ErrNotMinted = errors.New("content not minted")
+	// ErrNoMinterKey is returned for a custodial mint without a server key
+	ErrNoMinterKey = errors.New("no minter key is configured")
+)
+
+// NFTService mints and looks up LicenZNFT tokens. With a private key it can
+// mint on the user's behalf, paying the gas itself; without one it only
+// prepares mint transactions for the user's wallet.
+type NFTService struct {
+	client       chainBackend
+	contract     *contracts.LicenZNFT
+	contractAddr common.Address
+	abi          *abi.ABI
+	privateKey   *ecdsa.PrivateKey
+	fromAddress  common.Address
+}
+
+// Token is a minted LicenZNFT token. Creator is the address that sent the
+// mint, which for custodial mints is the server's.
+type Token struct {
+	ID          *big.Int       `json:"tokenId"`
+	ContentHash common.Hash    `json:"contentHash"`
+	Creator     common.Address `json:"creator"`
+	CreatedAt   *big.Int       `json:"createdAt"`
+	TokenURI    string         `json:"tokenURI"`
+}
+
+// MintResult describes a confirmed mintNFT transaction
+type MintResult struct {
+	Token       *Token      `json:"token"`
+	TxHash      common.Hash `json:"txHash"`
+	BlockNumber *big.Int    `json:"blockNumber"`
+}
+
+// NewNFTService connects to rpcURL and binds the LicenZNFT contract.
+// privateKeyHex may be empty, which disables custodial minting.
+func NewNFTService(rpcURL, contractAddress, privateKeyHex string) (*NFTService, error) {
+	client, err := ethclient.Dial(rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RPC: %v", err)
+	}
+
+	service, err := newNFTService(client, contractAddress, privateKeyHex)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	return service, nil
+}
+
+// newNFTService creates a service on top of an existing backend
+func newNFTService(client chainBackend, contractAddress, privateKeyHex string) (*NFTService, error) {
+	if !common.IsHexAddress(contractAddress) {
+		return nil, fmt.Errorf("invalid contract address %q", contractAddress)
+	}
+	contractAddr := common.HexToAddress(contractAddress)
+
+	contract, err := contracts.NewLicenZNFT(contractAddr, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract: %v", err)
+	}
+	parsed, err := contracts.LicenZNFTMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract ABI: %v", err)
+	}
+
+	service := &NFTService{
+		client:       client,
+		contract:     contract,
+		contractAddr: contractAddr,
+		abi:          parsed,
+	}
+	if privateKeyHex != "" {
+		if service.privateKey, service.fromAddress, err = parsePrivateKey(privateKeyHex); err != nil {
+			return nil, err
+		}
+	}
+	return service, nil
+}
+
+// ContractAddress is the address of the bound LicenZNFT contract
+func (s *NFTService) ContractAddress() common.Address {
+	return s.contractAddr
+}
+
+// Custodial reports whether the service can mint with its own key
+func (s *NFTService) Custodial() bool {
+	return s.privateKey != nil
+}
+
+// IsContentMinted reports whether a content hash has a token
+func (s *NFTService) IsContentMinted(contentHash common.Hash) (bool, error) {
+	minted, err := s.contract.IsContentMinted(s.callOpts(), contentHash)
+	if err != nil {
+		return false, fmt.Errorf("failed to call contract: %v", err)
+	}
+	return minted, nil
+}
+
+// TokenByContentHash retrieves the token minted for a content hash
+func (s *NFTService) TokenByContentHash(contentHash common.Hash) (*Token, error) {
+	// getTokenByContentHash reverts for unminted content, so ask first
+	minted, err := s.IsContentMinted(contentHash)
+	if err != nil {
+		return nil, err
+	}
+	if !minted {
+		return nil, ErrNotMinted
+	}
+
+	t, err := s.contract.GetTokenByContentHash(s.callOpts(), contentHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %v", err)
+	}
+	uri, err := s.TokenURI(t.TokenId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Token{
+		ID:          t.TokenId,
+		ContentHash: contentHash,
+		Creator:     t.Creator,
+		CreatedAt:   t.CreationTime,
+		TokenURI:    uri,
+	}, nil
+}
+
+// TokenByID retrieves a token by its ID
+func (s *NFTService) TokenByID(tokenID *big.Int) (*Token, error) {
+	contentHash, err := s.contract.TokenIdToContentHash(s.callOpts(), tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %v", err)
+	}
+	if contentHash == (common.Hash{}) {
+		return nil, ErrNotMinted
+	}
+	creator, err := s.contract.TokenCreators(s.callOpts(), tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %v", err)
+	}
+	createdAt, err := s.contract.TokenCreationTime(s.callOpts(), tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %v", err)
+	}
+	uri, err := s.TokenURI(tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Token{
+		ID:          tokenID,
+		ContentHash: contentHash,
+		Creator:     creator,
+		CreatedAt:   createdAt,
+		TokenURI:    uri,
+	}, nil
+}
+
+// TokenURI retrieves the metadata URI of a token
+func (s *NFTService) TokenURI(tokenID *big.Int) (string, error) {
+	uri, err := s.contract.TokenURI(s.callOpts(), tokenID)
+	if err != nil {
+		return "", fmt.Errorf("failed to call contract: %v", err)
+	}
+	return uri, nil
+}
+
+// Mint mints a token for contentHash to the given address with the
+// service's key, and waits for the transaction to be mined
+func (s *NFTService) Mint(to common.Address, contentHash common.Hash, uri string) (*MintResult, error) {
+	if s.privateKey == nil {
+		return nil, ErrNoMinterKey
+	}
+	if err := s.checkUnminted(contentHash); err != nil {
+		return nil, err
+	}
+
+	opts, err := transactOpts(context.Background(), s.client, s.privateKey)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := s.contract.MintNFT(opts, to, contentHash, uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send transaction: %v", err)
+	}
+
+	log.Printf("🪙 Minting NFT for %s to %s. Transaction: %s", contentHash.Hex(), to.Hex(), tx.Hash().Hex())
+
+	receipt, err := waitForTransaction(s.client, tx.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("transaction failed: %v", err)
+	}
+	return s.parseMintReceipt(receipt)
+}
+
+// BuildMintTx prepares a mintNFT transaction for the user's wallet. The
+// sender is recorded as the token's creator.
+func (s *NFTService) BuildMintTx(from, to common.Address, contentHash common.Hash, uri string) (*PreparedTx, error) {
+	if err := s.checkUnminted(contentHash); err != nil {
+		return nil, err
+	}
+
+	data, err := s.abi.Pack("mintNFT", to, contentHash, uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode mintNFT: %v", err)
+	}
+	return prepareTx(s.client, from, s.contractAddr, data, nil)
+}
+
+// ConfirmMint looks up a mintNFT transaction sent from a wallet. It does
+// not wait: ErrTxPending means the transaction has not been mined yet.
+func (s *NFTService) ConfirmMint(txHash common.Hash) (*MintResult, error) {
+	receipt, err := lookupReceipt(s.client, txHash)
+	if err != nil {
+		return nil, err
+	}
+	return s.parseMintReceipt(receipt)
+}
+
+// checkUnminted fails with ErrContentMinted for content that has a token,
+// which the contract would revert on
+func (s *NFTService) checkUnminted(contentHash common.Hash) error {
+	minted, err := s.IsContentMinted(contentHash)
+	if err != nil {
+		return err
+	}
+	if minted {
+		return ErrContentMinted
+	}
+	return nil
+}
+
+// parseMintReceipt reads the token from the NFTMinted event. Logs from other
+// contracts are ignored.
+func (s *NFTService) parseMintReceipt(receipt *types.Receipt) (*MintResult, error) {
+	for _, entry := range receipt.Logs {
+		if entry.Address != s.contractAddr {
+			continue
+		}
+		minted, err := s.contract.ParseNFTMinted(*entry)
+		if err != nil {
+			continue
+		}
+		return &MintResult{
+			Token: &Token{
+				ID:          minted.TokenId,
+				ContentHash: minted.ContentHash,
+				Creator:     minted.Creator,
+				CreatedAt:   minted.Timestamp,
+				TokenURI:    minted.TokenURI,
+			},
+			TxHash:      receipt.TxHash,
+			BlockNumber: receipt.BlockNumber,
+		}, nil
+	}
+	return nil, fmt.Errorf("no NFTMinted event in transaction %s", receipt.TxHash.Hex())
+}
+
+// callOpts are the options for read-only calls
+func (s *NFTService) callOpts() *bind.CallOpts {
+	return &bind.CallOpts{From: s.fromAddress, Context: context.Background()}
+}
+
+// Close closes the blockchain connection
+func (s *NFTService) Close() {
+	if closer, ok := s.client.(interface{ Close() }); ok {
+		closer.Close()
+	}
+}
@@ -0,0 +1,188 @@
+package services
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"licenz-backend/contracts"
+)
+
+const testTokenURI = "ipfs://QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+
+var testContentHash = common.HexToHash("0xba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad")
+
+func nftABI(t *testing.T) *abi.ABI {
+	t.Helper()
+	parsed, err := contracts.LicenZNFTMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+// nftRoute answers method with the packed outputs
+func nftRoute(t *testing.T, parsed *abi.ABI, method string, outputs ...interface{}) stubRoute {
+	t.Helper()
+	data, err := parsed.Methods[method].Outputs.Pack(outputs...)
+	if err != nil {
+		t.Fatalf("pack %s: %v", method, err)
+	}
+	return stubRoute{selector: parsed.Methods[method].ID, data: data}
+}
+
+// mintRoute answers mintNFT, emitting NFTMinted for token 5 sent by creator
+func mintRoute(t *testing.T, parsed *abi.ABI, creator common.Address) stubRoute {
+	t.Helper()
+	minted := parsed.Events["NFTMinted"]
+	event, err := minted.Inputs.NonIndexed().Pack(testTokenURI, big.NewInt(1700000000))
+	if err != nil {
+		t.Fatalf("pack event: %v", err)
+	}
+	route := nftRoute(t, parsed, "mintNFT", big.NewInt(5))
+	route.topics = []common.Hash{minted.ID, common.BigToHash(big.NewInt(5)), common.BytesToHash(creator.Bytes()), testContentHash}
+	route.event = event
+	return route
+}
+
+// newNFTBackend deploys a stub LicenZNFT and funds the test key
+func newNFTBackend(t *testing.T, key string, routes ...stubRoute) (*simulated.Backend, *NFTService) {
+	t.Helper()
+	signer, _ := crypto.HexToECDSA(testKey)
+	contract := common.HexToAddress("0x000000000000000000000000000000000000c0de")
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		crypto.PubkeyToAddress(signer.PublicKey): {Balance: big.NewInt(1e18)},
+		contract:                                 {Code: stubCode(routes...)},
+	})
+	t.Cleanup(func() { backend.Close() })
+
+	service, err := newNFTService(backend.Client(), contract.Hex(), key)
+	if err != nil {
+		t.Fatalf("newNFTService: %v", err)
+	}
+	return backend, service
+}
+
+func TestMintCustodial(t *testing.T) {
+	parsed := nftABI(t)
+	key, _ := crypto.HexToECDSA(testKey)
+	minter := crypto.PubkeyToAddress(key.PublicKey)
+	owner := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	backend, service := newNFTBackend(t, testKey,
+		nftRoute(t, parsed, "isContentMinted", false),
+		mintRoute(t, parsed, minter),
+	)
+	if !service.Custodial() {
+		t.Fatal("Custodial() = false with a minter key")
+	}
+
+	stop := mineUntilDone(backend)
+	result, err := service.Mint(owner, testContentHash, testTokenURI)
+	stop()
+	if err != nil {
+		t.Fatalf("Mint: %v", err)
+	}
+
+	token := result.Token
+	if token.ID.Int64() != 5 || token.ContentHash != testContentHash || token.Creator != minter || token.TokenURI != testTokenURI {
+		t.Errorf("token = %+v, want token 5 for the content hash minted by %s", token, minter.Hex())
+	}
+	if result.TxHash == (common.Hash{}) || result.BlockNumber.Sign() <= 0 {
+		t.Errorf("result = %+v, want a tx hash and block number", result)
+	}
+}
+
+func TestMintFromWallet(t *testing.T) {
+	parsed := nftABI(t)
+	key, _ := crypto.HexToECDSA(testKey)
+	wallet := crypto.PubkeyToAddress(key.PublicKey)
+	backend, service := newNFTBackend(t, "",
+		nftRoute(t, parsed, "isContentMinted", false),
+		mintRoute(t, parsed, wallet),
+	)
+
+	if _, err := service.Mint(wallet, testContentHash, testTokenURI); !errors.Is(err, ErrNoMinterKey) {
+		t.Errorf("Mint without a key = %v, want ErrNoMinterKey", err)
+	}
+
+	prepared, err := service.BuildMintTx(wallet, wallet, testContentHash, testTokenURI)
+	if err != nil {
+		t.Fatalf("BuildMintTx: %v", err)
+	}
+	wantData, _ := parsed.Pack("mintNFT", wallet, testContentHash, testTokenURI)
+	if prepared.From != wallet || string(prepared.Data) != string(wantData) || prepared.Value.ToInt().Sign() != 0 {
+		t.Errorf("prepared = %+v, want a mintNFT call from %s", prepared, wallet.Hex())
+	}
+
+	if _, err := service.ConfirmMint(common.HexToHash("0x01")); !errors.Is(err, ErrTxPending) {
+		t.Errorf("ConfirmMint of an unknown transaction = %v, want ErrTxPending", err)
+	}
+	result, err := service.ConfirmMint(sendPrepared(t, backend, prepared))
+	if err != nil {
+		t.Fatalf("ConfirmMint: %v", err)
+	}
+	if result.Token.ID.Int64() != 5 || result.Token.Creator != wallet {
+		t.Errorf("token = %+v, want token 5 minted by %s", result.Token, wallet.Hex())
+	}
+}
+
+func TestMintRejectsMintedContent(t *testing.T) {
+	parsed := nftABI(t)
+	wallet := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	_, service := newNFTBackend(t, testKey, nftRoute(t, parsed, "isContentMinted", true))
+
+	if _, err := service.Mint(wallet, testContentHash, testTokenURI); !errors.Is(err, ErrContentMinted) {
+		t.Errorf("Mint = %v, want ErrContentMinted", err)
+	}
+	if _, err := service.BuildMintTx(wallet, wallet, testContentHash, testTokenURI); !errors.Is(err, ErrContentMinted) {
+		t.Errorf("BuildMintTx = %v, want ErrContentMinted", err)
+	}
+}
+
+func TestTokenLookups(t *testing.T) {
+	parsed := nftABI(t)
+	creator := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	_, service := newNFTBackend(t, "",
+		nftRoute(t, parsed, "isContentMinted", true),
+		nftRoute(t, parsed, "getTokenByContentHash", big.NewInt(5), creator, big.NewInt(1700000000)),
+		nftRoute(t, parsed, "tokenURI", testTokenURI),
+		nftRoute(t, parsed, "tokenIdToContentHash", testContentHash),
+		nftRoute(t, parsed, "tokenCreators", creator),
+		nftRoute(t, parsed, "tokenCreationTime", big.NewInt(1700000000)),
+	)
+
+	byHash, err := service.TokenByContentHash(testContentHash)
+	if err != nil {
+		t.Fatalf("TokenByContentHash: %v", err)
+	}
+	byID, err := service.TokenByID(big.NewInt(5))
+	if err != nil {
+		t.Fatalf("TokenByID: %v", err)
+	}
+	for _, token := range []*Token{byHash, byID} {
+		if token.ID.Int64() != 5 || token.ContentHash != testContentHash || token.Creator != creator ||
+			token.CreatedAt.Int64() != 1700000000 || token.TokenURI != testTokenURI {
+			t.Errorf("token = %+v, want token 5 for the content hash", token)
+		}
+	}
+}
+
+func TestTokenNotMinted(t *testing.T) {
+	parsed := nftABI(t)
+	_, service := newNFTBackend(t, "",
+		nftRoute(t, parsed, "isContentMinted", false),
+		nftRoute(t, parsed, "tokenIdToContentHash", common.Hash{}),
+	)
+
+	if _, err := service.TokenByContentHash(testContentHash); !errors.Is(err, ErrNotMinted) {
+		t.Errorf("TokenByContentHash = %v, want ErrNotMinted", err)
+	}
+	if _, err := service.TokenByID(big.NewInt(5)); !errors.Is(err, ErrNotMinted) {
+		t.Errorf("TokenByID = %v, want ErrNotMinted", err)
+	}
+}
@@ -0,0 +1,303 @@
+package uploads
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"licenz-backend/fsutil"
+	"licenz-backend/imaging"
+)
+
+// DefaultTTL is how long an unfinished upload is kept
+const DefaultTTL = 24 * time.Hour
+
+// stagingDir holds single-request uploads while they are checked
+const stagingDir = "staging"
+
+// Errors returned by Manager
+var (
+	ErrNotFound       = errors.New("upload not found")
+	ErrOffsetMismatch = errors.New("upload offset does not match")
+	ErrExceedsLength  = errors.New("chunk extends past the declared upload length")
+	ErrIncomplete     = errors.New("upload is not complete")
+	ErrBusy           = errors.New("upload is already receiving a chunk")
+	ErrTooMany        = errors.New("too many unfinished uploads")
+)
+
+// Upload is a resumable upload. Its bytes are appended to <id>.bin; the
+// received offset is the size of that file, so a chunk cut short by a
+// dropped connection still counts for what arrived.
+type Upload struct {
+	ID        string            `json:"id"`
+	Owner     string            `json:"owner"`
+	Length    int64             `json:"length"`
+	Offset    int64             `json:"-"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	ExpiresAt time.Time         `json:"expires_at"`
+	QuotaDay  string            `json:"quota_day,omitempty"` // day Length was charged to a storage quota
+}
+
+// Complete reports whether every byte has arrived
+func (u *Upload) Complete() bool {
+	return u.Offset == u.Length
+}
+
+// Manager keeps resumable uploads in a directory
+type Manager struct {
+	dir      string
+	maxBytes int64
+	maxOpen  int
+	ttl      time.Duration
+	now      func() time.Time
+	onExpire func(*Upload)
+
+	createMutex sync.Mutex // serializes counting and creating uploads
+	mutex       sync.Mutex
+	busy        map[string]bool // uploads receiving a chunk
+}
+
+// NewManager keeps uploads of up to maxBytes under dir, at most maxOpen
+// unfinished ones per owner (0 = no limit). Files left in the staging
+// directory by an earlier run are removed.
+func NewManager(dir string, maxBytes int64, maxOpen int, ttl time.Duration) (*Manager, error) {
+	staging := filepath.Join(dir, stagingDir)
+	if err := os.RemoveAll(staging); err != nil {
+		return nil, fmt.Errorf("failed to clear staging directory: %v", err)
+	}
+	if err := os.MkdirAll(staging, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create upload directory: %v", err)
+	}
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Manager{dir: dir, maxBytes: maxBytes, maxOpen: maxOpen, ttl: ttl, now: time.Now, busy: make(map[string]bool)}, nil
+}
+
+// MaxBytes returns the largest upload accepted
+func (m *Manager) MaxBytes() int64 {
+	return m.maxBytes
+}
+
+// StageDir is where Stage should put single-request uploads
+func (m *Manager) StageDir() string {
+	return filepath.Join(m.dir, stagingDir)
+}
+
+// OnExpire sets fn to be called for each upload swept after its expiry,
+// so whatever was reserved for it can be returned. Set it before use.
+func (m *Manager) OnExpire(fn func(*Upload)) {
+	m.onExpire = fn
+}
+
+// Create starts an upload of length bytes for owner, recording the day its
+// length was charged to owner's storage quota
+func (m *Manager) Create(owner string, length int64, quotaDay string, metadata map[string]string) (*Upload, error) {
+	if length <= 0 {
+		return nil, errors.New("upload length must be positive")
+	}
+	if m.maxBytes > 0 && length > m.maxBytes {
+		return nil, imaging.ErrTooLarge
+	}
+
+	m.createMutex.Lock()
+	defer m.createMutex.Unlock()
+	if open := m.sweep(); m.maxOpen > 0 && open[owner] >= m.maxOpen {
+		return nil, ErrTooMany
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	now := m.now().UTC()
+	u := &Upload{
+		ID:        hex.EncodeToString(id),
+		Owner:     owner,
+		Length:    length,
+		Metadata:  metadata,
+		CreatedAt: now,
+		ExpiresAt: now.Add(m.ttl),
+		QuotaDay:  quotaDay,
+	}
+
+	data, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal upload: %v", err)
+	}
+	if err := os.WriteFile(m.dataPath(u.ID), nil, 0600); err != nil {
+		return nil, fmt.Errorf("failed to create upload: %v", err)
+	}
+	if err := fsutil.WriteFileAtomic(m.infoPath(u.ID), data, 0600); err != nil {
+		os.Remove(m.dataPath(u.ID))
+		return nil, err
+	}
+	return u, nil
+}
+
+// Get returns owner's upload with its current offset. Uploads that belong
+// to someone else or have expired are not found.
+func (m *Manager) Get(owner, id string) (*Upload, error) {
+	if !validID(id) {
+		return nil, ErrNotFound
+	}
+
+	data, err := os.ReadFile(m.infoPath(id))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %v", err)
+	}
+
+	var u Upload
+	if err := json.Unmarshal(data, &u); err != nil {
+		return nil, fmt.Errorf("failed to read upload: %v", err)
+	}
+	if u.Owner != owner || m.now().After(u.ExpiresAt) {
+		return nil, ErrNotFound
+	}
+
+	stat, err := os.Stat(m.dataPath(id))
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	u.Offset = stat.Size()
+	return &u, nil
+}
+
+// Append writes a chunk that starts at offset and returns the new offset.
+// offset must equal the bytes received so far, and the chunk may not run
+// past the declared length.
+func (m *Manager) Append(owner, id string, offset int64, r io.Reader) (int64, error) {
+	if !m.acquire(id) {
+		return 0, ErrBusy
+	}
+	defer m.release(id)
+
+	u, err := m.Get(owner, id)
+	if err != nil {
+		return 0, err
+	}
+	if offset != u.Offset {
+		return u.Offset, ErrOffsetMismatch
+	}
+
+	file, err := os.OpenFile(m.dataPath(id), os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return u.Offset, fmt.Errorf("failed to open upload: %v", err)
+	}
+	defer file.Close()
+
+	remaining := u.Length - u.Offset
+	n, err := io.Copy(file, io.LimitReader(r, remaining+1))
+	if n > remaining {
+		// Drop the overflow so the upload stays resumable
+		file.Truncate(u.Length)
+		return u.Length, ErrExceedsLength
+	}
+	if err != nil {
+		return u.Offset + n, fmt.Errorf("failed to receive chunk: %v", err)
+	}
+	return u.Offset + n, nil
+}
+
+// Finish hashes a complete upload and returns it as a File. The File stays
+// owned by the manager: call Terminate once it has been stored.
+func (m *Manager) Finish(owner, id string) (*File, error) {
+	if !m.acquire(id) {
+		return nil, ErrBusy
+	}
+	defer m.release(id)
+
+	u, err := m.Get(owner, id)
+	if err != nil {
+		return nil, err
+	}
+	if !u.Complete() {
+		return nil, ErrIncomplete
+	}
+	return hashFile(m.dataPath(id))
+}
+
+// Terminate deletes owner's upload and returns it
+func (m *Manager) Terminate(owner, id string) (*Upload, error) {
+	if !m.acquire(id) {
+		return nil, ErrBusy
+	}
+	defer m.release(id)
+
+	u, err := m.Get(owner, id)
+	if err != nil {
+		return nil, err
+	}
+	m.remove(id)
+	return u, nil
+}
+
+// sweep deletes uploads past their expiry and counts the remaining ones
+// by owner
+func (m *Manager) sweep() map[string]int {
+	infos, _ := filepath.Glob(filepath.Join(m.dir, "*.json"))
+	now := m.now()
+	open := make(map[string]int)
+	for _, path := range infos {
+		var u Upload
+		data, err := os.ReadFile(path)
+		parsed := err == nil && json.Unmarshal(data, &u) == nil
+		if parsed && now.Before(u.ExpiresAt) {
+			open[u.Owner]++
+			continue
+		}
+		m.remove(strings.TrimSuffix(filepath.Base(path), ".json"))
+		if parsed && m.onExpire != nil {
+			m.onExpire(&u)
+		}
+	}
+	return open
+}
+
+// remove deletes an upload's files
+func (m *Manager) remove(id string) {
+	os.Remove(m.dataPath(id))
+	os.Remove(m.infoPath(id))
+}
+
+// acquire marks id busy, reporting false if it already was
+func (m *Manager) acquire(id string) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.busy[id] {
+		return false
+	}
+	m.busy[id] = true
+	return true
+}
+
+// release clears acquire
+func (m *Manager) release(id string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.busy, id)
+}
+
+func (m *Manager) dataPath(id string) string { return filepath.Join(m.dir, id+".bin") }
+func (m *Manager) infoPath(id string) string { return filepath.Join(m.dir, id+".json") }
+
+// validID reports whether id is one Create could have issued
+func validID(id string) bool {
+	if len(id) != 32 {
+		return false
+	}
+	_, err := hex.DecodeString(id)
+	return err == nil
+}
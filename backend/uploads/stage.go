@@ -0,0 +1,89 @@
+// Package uploads receives image files without holding them in memory.
+// Stage streams a single request body to a temporary file, hashing it on
+// the way; Manager implements resumable uploads sent in chunks over several
+// requests, in the style of the tus protocol.
+package uploads
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"licenz-backend/contenthash"
+	"licenz-backend/imaging"
+)
+
+// File is a complete upload on local disk, hashed and ready to validate
+type File struct {
+	Path   string
+	Size   int64
+	Hashes contenthash.Hashes
+	temp   bool // removed by Remove
+}
+
+// Open opens the file for reading
+func (f *File) Open() (*os.File, error) {
+	return os.Open(f.Path)
+}
+
+// Remove deletes a temporary file; files owned by a Manager are left to it
+func (f *File) Remove() {
+	if f.temp {
+		os.Remove(f.Path)
+	}
+}
+
+// Stage copies r to a temporary file in dir, hashing as it goes. More than
+// maxBytes (if positive) fails with imaging.ErrTooLarge as soon as the
+// limit is crossed.
+func Stage(dir string, r io.Reader, maxBytes int64) (*File, error) {
+	tmp, err := os.CreateTemp(dir, "licenz-upload-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file: %v", err)
+	}
+	f := &File{Path: tmp.Name(), temp: true}
+
+	size, hashes, err := copyHashed(tmp, r, maxBytes)
+	if cerr := tmp.Close(); err == nil && cerr != nil {
+		err = fmt.Errorf("failed to write upload: %v", cerr)
+	}
+	if err != nil {
+		f.Remove()
+		return nil, err
+	}
+
+	f.Size, f.Hashes = size, hashes
+	return f, nil
+}
+
+// hashFile hashes a file already on disk
+func hashFile(path string) (*File, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	size, hashes, err := copyHashed(io.Discard, file, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &File{Path: path, Size: size, Hashes: hashes}, nil
+}
+
+// copyHashed copies r to w, hashing the bytes and enforcing maxBytes
+func copyHashed(w io.Writer, r io.Reader, maxBytes int64) (int64, contenthash.Hashes, error) {
+	hasher := contenthash.NewHasher()
+	if maxBytes > 0 {
+		r = io.LimitReader(r, maxBytes+1)
+	}
+
+	n, err := io.Copy(io.MultiWriter(w, hasher), r)
+	if err != nil {
+		return 0, contenthash.Hashes{}, fmt.Errorf("failed to receive upload: %v", err)
+	}
+	if maxBytes > 0 && n > maxBytes {
+		return 0, contenthash.Hashes{}, imaging.ErrTooLarge
+	}
+	return n, hasher.Sum(), nil
+}
@@ -0,0 +1,144 @@
+package uploads
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"licenz-backend/contenthash"
+	"licenz-backend/imaging"
+)
+
+func TestStage(t *testing.T) {
+	data := []byte(strings.Repeat("image", 1000))
+
+	f, err := Stage(t.TempDir(), bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Stage: %v", err)
+	}
+	defer f.Remove()
+
+	if f.Size != int64(len(data)) || f.Hashes != contenthash.Compute(data) {
+		t.Fatalf("staged %d bytes with %+v", f.Size, f.Hashes)
+	}
+	if got, _ := os.ReadFile(f.Path); !bytes.Equal(got, data) {
+		t.Fatal("staged bytes differ")
+	}
+
+	if _, err := Stage(t.TempDir(), bytes.NewReader(data), int64(len(data))-1); !errors.Is(err, imaging.ErrTooLarge) {
+		t.Fatalf("oversized Stage = %v", err)
+	}
+}
+
+// failingReader returns some bytes, then an error, like a dropped connection
+type failingReader struct{ data []byte }
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestResumableUpload(t *testing.T) {
+	m, err := NewManager(t.TempDir(), 100, 0, time.Hour)
+	if err != nil {
+		t.Fatalf("NewManager: %v", err)
+	}
+	data := []byte(strings.Repeat("0123456789", 3))
+
+	if _, err := m.Create("alice", 101, "", nil); !errors.Is(err, imaging.ErrTooLarge) {
+		t.Fatalf("oversized Create = %v", err)
+	}
+	u, err := m.Create("alice", int64(len(data)), "2026-01-01", map[string]string{"filename": "a.png"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if got, err := m.Get("alice", u.ID); err != nil || got.QuotaDay != "2026-01-01" {
+		t.Fatalf("Get = %+v, %v; want the quota day kept", got, err)
+	}
+
+	if _, err := m.Get("bob", u.ID); err != ErrNotFound {
+		t.Fatalf("Get by another owner = %v", err)
+	}
+
+	// A chunk cut short still counts for what arrived
+	offset, err := m.Append("alice", u.ID, 0, &failingReader{data: data[:12]})
+	if err == nil || offset != 12 {
+		t.Fatalf("interrupted Append = %d, %v", offset, err)
+	}
+	if _, err := m.Append("alice", u.ID, 0, bytes.NewReader(data)); err != ErrOffsetMismatch {
+		t.Fatalf("Append at stale offset = %v", err)
+	}
+	if _, err := m.Finish("alice", u.ID); err != ErrIncomplete {
+		t.Fatalf("Finish before the end = %v", err)
+	}
+
+	// Bytes past the declared length are refused and dropped
+	if offset, err = m.Append("alice", u.ID, 12, bytes.NewReader(append(data[12:], 'x'))); err != ErrExceedsLength {
+		t.Fatalf("overlong Append = %d, %v", offset, err)
+	}
+	got, err := m.Get("alice", u.ID)
+	if err != nil || !got.Complete() {
+		t.Fatalf("Get after the last chunk = %+v, %v", got, err)
+	}
+
+	f, err := m.Finish("alice", u.ID)
+	if err != nil || f.Hashes != contenthash.Compute(data) {
+		t.Fatalf("Finish = %+v, %v", f, err)
+	}
+	f.Remove() // owned by the manager, so still there
+	if _, err := os.Stat(f.Path); err != nil {
+		t.Fatalf("Remove deleted a managed upload: %v", err)
+	}
+
+	if _, err := m.Terminate("alice", u.ID); err != nil {
+		t.Fatalf("Terminate: %v", err)
+	}
+	if _, err := m.Get("alice", u.ID); err != ErrNotFound {
+		t.Fatalf("Get after Terminate = %v", err)
+	}
+}
+
+func TestExpiredUploadsAreSwept(t *testing.T) {
+	m, _ := NewManager(t.TempDir(), 0, 0, time.Hour)
+	old, _ := m.Create("alice", 10, "", nil)
+	var expired []string
+	m.OnExpire(func(u *Upload) { expired = append(expired, u.ID) })
+
+	m.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if _, err := m.Get("alice", old.ID); err != ErrNotFound {
+		t.Fatalf("expired upload = %v", err)
+	}
+	m.Create("alice", 10, "", nil)
+	if _, err := os.Stat(m.dataPath(old.ID)); !os.IsNotExist(err) {
+		t.Fatalf("expired upload not swept: %v", err)
+	}
+	if len(expired) != 1 || expired[0] != old.ID {
+		t.Fatalf("OnExpire saw %v, want %s", expired, old.ID)
+	}
+}
+
+func TestOpenUploadsPerOwner(t *testing.T) {
+	m, _ := NewManager(t.TempDir(), 0, 2, time.Hour)
+	first, _ := m.Create("alice", 10, "", nil)
+	m.Create("alice", 10, "", nil)
+
+	if _, err := m.Create("alice", 10, "", nil); err != ErrTooMany {
+		t.Fatalf("third Create = %v, want ErrTooMany", err)
+	}
+	if _, err := m.Create("bob", 10, "", nil); err != nil {
+		t.Fatalf("Create for another owner: %v", err)
+	}
+
+	m.Terminate("alice", first.ID)
+	if _, err := m.Create("alice", 10, "", nil); err != nil {
+		t.Fatalf("Create after Terminate: %v", err)
+	}
+}